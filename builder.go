@@ -2,11 +2,14 @@ package gosdk
 
 import (
 	"errors"
+	"fmt"
+	"slices"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/oddin-gg/gosdk/internal/cache"
 	"github.com/oddin-gg/gosdk/internal/factory"
-	"github.com/oddin-gg/gosdk/internal/feed"
+	"github.com/oddin-gg/gosdk/internal/journal"
 	"github.com/oddin-gg/gosdk/internal/producer"
 	"github.com/oddin-gg/gosdk/internal/recovery"
 	"github.com/oddin-gg/gosdk/protocols"
@@ -17,12 +20,16 @@ type builderImpl struct {
 	sessionMap               map[uuid.UUID]*sessionData
 	messageInterest          *protocols.MessageInterest
 	eventIDS                 map[protocols.URN]struct{}
+	sportIDs                 map[protocols.URN]struct{}
+	tournamentIDs            map[protocols.URN]struct{}
 	oddsFeedConfiguration    protocols.OddsFeedConfiguration
-	rabbitMQClient           *feed.Client
+	transport                protocols.Transport
+	journal                  *journal.Writer
 	producerManager          *producer.Manager
 	cacheManager             *cache.Manager
 	feedMessageFactory       *factory.FeedMessageFactory
 	recoveryMessageProcessor protocols.RecoveryMessageProcessor
+	options                  sessionOptions
 	logger                   *log.Entry
 }
 
@@ -47,13 +54,101 @@ func (b *builderImpl) SetSpecificEventOnly(specificEventOnly protocols.URN) prot
 	return b
 }
 
+func (b *builderImpl) SetSpecificSportsOnly(sportIDs []protocols.URN) protocols.OddsFeedSessionBuilder {
+	b.sportIDs = make(map[protocols.URN]struct{}, len(sportIDs))
+	for i := range sportIDs {
+		b.sportIDs[sportIDs[i]] = struct{}{}
+	}
+
+	return b
+}
+
+func (b *builderImpl) SetSpecificTournamentsOnly(tournamentIDs []protocols.URN) protocols.OddsFeedSessionBuilder {
+	b.tournamentIDs = make(map[protocols.URN]struct{}, len(tournamentIDs))
+	for i := range tournamentIDs {
+		b.tournamentIDs[tournamentIDs[i]] = struct{}{}
+	}
+
+	return b
+}
+
+func (b *builderImpl) SetProcessingWorkers(workers int) protocols.OddsFeedSessionBuilder {
+	b.options.workers = workers
+	return b
+}
+
+func (b *builderImpl) SetOverflow(params protocols.OverflowParams) protocols.OddsFeedSessionBuilder {
+	b.options.overflow = params
+	return b
+}
+
+func (b *builderImpl) SetDeduplication(window time.Duration) protocols.OddsFeedSessionBuilder {
+	b.options.deduplicationWindow = window
+	return b
+}
+
+func (b *builderImpl) SetFilter(filter protocols.SessionFilter) protocols.OddsFeedSessionBuilder {
+	b.options.filter = &filter
+	return b
+}
+
+func (b *builderImpl) SetRequeueOnListenerError(requeue bool) protocols.OddsFeedSessionBuilder {
+	b.options.requeueOnListenerError = requeue
+	return b
+}
+
+func (b *builderImpl) AddMiddleware(middleware protocols.MessageMiddleware) protocols.OddsFeedSessionBuilder {
+	b.options.middlewares = append(slices.Clip(b.options.middlewares), middleware)
+	return b
+}
+
 func (b *builderImpl) Build() (protocols.SessionMessageDelivery, error) {
-	if b.messageInterest == nil {
+	session, err := b.BuildSession()
+	if err != nil {
+		return nil, err
+	}
+
+	return session.RespCh(), nil
+}
+
+func (b *builderImpl) BuildWithListener(listener protocols.OddsFeedListener) (protocols.OddsFeedSession, error) {
+	if listener == nil {
+		return nil, errors.New("listener is not specified")
+	}
+
+	return b.buildSession(listener)
+}
+
+func (b *builderImpl) BuildSession() (protocols.OddsFeedSession, error) {
+	return b.buildSession(nil)
+}
+
+func (b *builderImpl) buildSession(listener protocols.OddsFeedListener) (protocols.OddsFeedSession, error) {
+	switch {
+	case b.messageInterest == nil:
 		return nil, errors.New("message interest is not specified")
+	case *b.messageInterest == protocols.SpecifiedSportsOnlyMessageInterest && len(b.sportIDs) == 0:
+		return nil, errors.New("sports are not specified")
+	case *b.messageInterest == protocols.SpecifiedTournamentsOnlyMessageInterest && len(b.tournamentIDs) == 0:
+		return nil, errors.New("tournaments are not specified")
+	}
+
+	err := b.checkQueueName(*b.messageInterest, false)
+	if err != nil {
+		return nil, err
+	}
+
+	options := b.options
+	options.listener = listener
+	options.index = len(b.sessionMap) + 1
+	if *b.messageInterest == protocols.SpecifiedTournamentsOnlyMessageInterest {
+		options.tournamentIDs = b.tournamentIDs
 	}
 
 	session := newSession(
-		b.rabbitMQClient,
+		b.oddsFeedConfiguration,
+		b.transport,
+		b.journal,
 		b.producerManager,
 		b.cacheManager,
 		b.feedMessageFactory,
@@ -61,21 +156,33 @@ func (b *builderImpl) Build() (protocols.SessionMessageDelivery, error) {
 		b.oddsFeedConfiguration.ExchangeName(),
 		b.oddsFeedConfiguration.SportIDPrefix(),
 		false,
+		options,
 		b.logger,
 	)
 	sessionData := &sessionData{
 		session:         session,
 		messageInterest: b.messageInterest,
 		eventIDs:        b.eventIDS,
+		sportIDs:        b.sportIDs,
 	}
 	b.sessionMap[session.ID()] = sessionData
 
-	return session.RespCh(), nil
+	return session, nil
 }
 
 func (b *builderImpl) BuildReplay() (protocols.SessionMessageDelivery, error) {
+	err := b.checkQueueName(protocols.AllMessageInterest, true)
+	if err != nil {
+		return nil, err
+	}
+
+	options := b.options
+	options.index = len(b.sessionMap) + 1
+
 	session := newSession(
-		b.rabbitMQClient,
+		b.oddsFeedConfiguration,
+		b.transport,
+		b.journal,
 		b.producerManager,
 		b.cacheManager,
 		b.feedMessageFactory,
@@ -83,6 +190,7 @@ func (b *builderImpl) BuildReplay() (protocols.SessionMessageDelivery, error) {
 		b.oddsFeedConfiguration.ReplayExchangeName(),
 		b.oddsFeedConfiguration.SportIDPrefix(),
 		true,
+		options,
 		b.logger,
 	)
 
@@ -97,9 +205,31 @@ func (b *builderImpl) BuildReplay() (protocols.SessionMessageDelivery, error) {
 	return session.RespCh(), nil
 }
 
+// checkQueueName rejects session which would consume from the durable queue of another session,
+// broker would split messages between both sessions
+func (b *builderImpl) checkQueueName(messageInterest protocols.MessageInterest, isReplay bool) error {
+	name := queueName(b.oddsFeedConfiguration, messageInterest, isReplay)
+	if len(name) == 0 {
+		return nil
+	}
+
+	for _, data := range b.sessionMap {
+		if data.messageInterest == nil {
+			continue
+		}
+
+		if queueName(b.oddsFeedConfiguration, *data.messageInterest, data.session.IsReplay()) == name {
+			return fmt.Errorf("durable queue %s is already used by another session", name)
+		}
+	}
+
+	return nil
+}
+
 type sessionData struct {
 	session         sdkOddsFeedSession
 	messageInterest *protocols.MessageInterest
 	eventIDs        map[protocols.URN]struct{}
+	sportIDs        map[protocols.URN]struct{}
 	isAliveOnly     bool
 }
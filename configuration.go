@@ -1,6 +1,11 @@
 package gosdk
 
-import "github.com/oddin-gg/gosdk/protocols"
+import (
+	"time"
+
+	"github.com/oddin-gg/gosdk/internal/utils"
+	"github.com/oddin-gg/gosdk/protocols"
+)
 
 type configuration struct {
 	accessToken                 *string
@@ -16,6 +21,21 @@ type configuration struct {
 	forcedMQURL                 string
 	exchangeName                string
 	sportIDPrefix               string
+	transport                   protocols.Transport
+	queueOptions                protocols.QueueOptions
+	channelBufferSize           int
+	journal                     *protocols.JournalParams
+	recoveryStateStore          protocols.RecoveryStateStore
+	recoveryCoordinator         protocols.RecoveryCoordinator
+	clock                       protocols.Clock
+	recoveryInitialDelay        time.Duration
+	recoveryTickPeriod          time.Duration
+	slowProcessingThreshold     time.Duration
+	recoveryHistorySize         int
+	recoveryHistorySink         protocols.RecoveryHistorySink
+	syntheticSuspension         bool
+	apiTimeout                  time.Duration
+	staticDataRefreshPeriod     time.Duration
 }
 
 func (o configuration) ExchangeName() string {
@@ -112,6 +132,163 @@ func (o configuration) SetSportIDPrefix(prefix string) protocols.OddsFeedConfigu
 	return o
 }
 
+func (o configuration) Transport() protocols.Transport {
+	return o.transport
+}
+
+func (o configuration) SetTransport(transport protocols.Transport) protocols.OddsFeedConfiguration {
+	o.transport = transport
+	return o
+}
+
+func (o configuration) QueueOptions() protocols.QueueOptions {
+	return o.queueOptions
+}
+
+func (o configuration) SetQueueOptions(options protocols.QueueOptions) protocols.OddsFeedConfiguration {
+	o.queueOptions = options
+	return o
+}
+
+func (o configuration) ChannelBufferSize() int {
+	return o.channelBufferSize
+}
+
+func (o configuration) SetChannelBufferSize(size int) protocols.OddsFeedConfiguration {
+	o.channelBufferSize = size
+	return o
+}
+
+func (o configuration) Journal() *protocols.JournalParams {
+	return o.journal
+}
+
+func (o configuration) SetJournal(params protocols.JournalParams) protocols.OddsFeedConfiguration {
+	o.journal = &params
+	return o
+}
+
+func (o configuration) RecoveryStateStore() protocols.RecoveryStateStore {
+	return o.recoveryStateStore
+}
+
+func (o configuration) SetRecoveryStateStore(store protocols.RecoveryStateStore) protocols.OddsFeedConfiguration {
+	o.recoveryStateStore = store
+	return o
+}
+
+func (o configuration) RecoveryCoordinator() protocols.RecoveryCoordinator {
+	return o.recoveryCoordinator
+}
+
+func (o configuration) SetRecoveryCoordinator(coordinator protocols.RecoveryCoordinator) protocols.OddsFeedConfiguration {
+	o.recoveryCoordinator = coordinator
+	return o
+}
+
+func (o configuration) Clock() protocols.Clock {
+	return o.clock
+}
+
+func (o configuration) SetClock(clock protocols.Clock) protocols.OddsFeedConfiguration {
+	o.clock = clock
+	return o
+}
+
+func (o configuration) RecoveryInitialDelay() time.Duration {
+	return o.recoveryInitialDelay
+}
+
+func (o configuration) SetRecoveryInitialDelay(delay time.Duration) protocols.OddsFeedConfiguration {
+	if delay <= 0 {
+		return o
+	}
+
+	o.recoveryInitialDelay = delay
+	return o
+}
+
+func (o configuration) RecoveryTickPeriod() time.Duration {
+	return o.recoveryTickPeriod
+}
+
+func (o configuration) SetRecoveryTickPeriod(period time.Duration) protocols.OddsFeedConfiguration {
+	// Ticker panics with non-positive period
+	if period <= 0 {
+		return o
+	}
+
+	o.recoveryTickPeriod = period
+	return o
+}
+
+func (o configuration) SlowProcessingThreshold() time.Duration {
+	return o.slowProcessingThreshold
+}
+
+func (o configuration) SetSlowProcessingThreshold(threshold time.Duration) protocols.OddsFeedConfiguration {
+	if threshold <= 0 {
+		return o
+	}
+
+	o.slowProcessingThreshold = threshold
+	return o
+}
+
+func (o configuration) RecoveryHistorySize() int {
+	return o.recoveryHistorySize
+}
+
+func (o configuration) SetRecoveryHistorySize(size int) protocols.OddsFeedConfiguration {
+	o.recoveryHistorySize = size
+	return o
+}
+
+func (o configuration) RecoveryHistorySink() protocols.RecoveryHistorySink {
+	return o.recoveryHistorySink
+}
+
+func (o configuration) SetRecoveryHistorySink(sink protocols.RecoveryHistorySink) protocols.OddsFeedConfiguration {
+	o.recoveryHistorySink = sink
+	return o
+}
+
+func (o configuration) SyntheticSuspension() bool {
+	return o.syntheticSuspension
+}
+
+func (o configuration) SetSyntheticSuspension(enabled bool) protocols.OddsFeedConfiguration {
+	o.syntheticSuspension = enabled
+	return o
+}
+
+func (o configuration) APITimeout() time.Duration {
+	return o.apiTimeout
+}
+
+func (o configuration) SetAPITimeout(timeout time.Duration) protocols.OddsFeedConfiguration {
+	if timeout <= 0 {
+		return o
+	}
+
+	o.apiTimeout = timeout
+	return o
+}
+
+func (o configuration) StaticDataRefreshPeriod() time.Duration {
+	return o.staticDataRefreshPeriod
+}
+
+func (o configuration) SetStaticDataRefreshPeriod(period time.Duration) protocols.OddsFeedConfiguration {
+	// Ticker panics with non-positive period
+	if period <= 0 {
+		return o
+	}
+
+	o.staticDataRefreshPeriod = period
+	return o
+}
+
 // NewConfiguration ...
 func NewConfiguration(accessToken string, environment protocols.Environment, nodeID int, reportExtendedData bool) protocols.OddsFeedConfiguration {
 	return &configuration{
@@ -126,5 +303,12 @@ func NewConfiguration(accessToken string, environment protocols.Environment, nod
 		reportExtendedData:          reportExtendedData,
 		exchangeName:                "oddinfeed",
 		sportIDPrefix:               "od:sport:",
+		clock:                       utils.SystemClock{},
+		recoveryInitialDelay:        60 * time.Second,
+		recoveryTickPeriod:          10 * time.Second,
+		slowProcessingThreshold:     time.Second,
+		recoveryHistorySize:         1000,
+		apiTimeout:                  10 * time.Second,
+		staticDataRefreshPeriod:     24 * time.Hour,
 	}
 }
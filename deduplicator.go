@@ -0,0 +1,49 @@
+package gosdk
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/oddin-gg/gosdk/protocols"
+	"github.com/patrickmn/go-cache"
+)
+
+// requestIDAttribute differs between live and recovered copy of the same message
+var requestIDAttribute = regexp.MustCompile(`\s+request_id="[^"]*"`)
+
+// deduplicator remembers processed messages for a limited time window
+type deduplicator struct {
+	seen *cache.Cache
+}
+
+// isDuplicate marks message as seen and reports whether it was already seen within the window
+func (d *deduplicator) isDuplicate(feedMessage *protocols.FeedMessage) bool {
+	return d.seen.Add(d.key(feedMessage), struct{}{}, cache.DefaultExpiration) != nil
+}
+
+func (d *deduplicator) key(feedMessage *protocols.FeedMessage) string {
+	var eventID string
+	if feedMessage.RoutingKey != nil && feedMessage.RoutingKey.EventID != nil {
+		eventID = feedMessage.RoutingKey.EventID.ToString()
+	}
+
+	hash := sha256.Sum256(requestIDAttribute.ReplaceAll(feedMessage.RawMessage, nil))
+
+	return fmt.Sprintf(
+		"%d|%s|%T|%d|%s",
+		feedMessage.Message.Product(),
+		eventID,
+		feedMessage.Message,
+		feedMessage.Message.Timestamp().UnixNano(),
+		hex.EncodeToString(hash[:]),
+	)
+}
+
+func newDeduplicator(window time.Duration) *deduplicator {
+	return &deduplicator{
+		seen: cache.New(window, window),
+	}
+}
@@ -507,6 +507,11 @@ func (e *Example) startFeed() {
 				e.handleFeedMessage(sessionMsg, requestMsg.RequestID())
 
 			case feedMsg := <-e.feedChannel:
+				if feedMsg.ConnectionStatus != nil {
+					e.handleConnectionStatus(feedMsg.ConnectionStatus)
+					continue
+				}
+
 				if feedMsg.Recovery == nil {
 					continue
 				}
@@ -519,6 +524,14 @@ func (e *Example) startFeed() {
 	}()
 }
 
+func (e *Example) handleConnectionStatus(status protocols.ConnectionStatus) {
+	if status.IsDown() {
+		log.Printf("feed connection is down - state %d, attempt %d", status.State(), status.Attempt())
+		return
+	}
+	log.Printf("feed connection is up")
+}
+
 func (e *Example) handleRecoveryMessage(recoveryMsg *protocols.RecoveryMessage) {
 	if recoveryMsg.EventRecoveryMessage != nil {
 		log.Printf("event recovery message for event %s with requestID %d", recoveryMsg.EventRecoveryMessage.EventID().ToString(),
@@ -1,14 +1,18 @@
 package gosdk
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"sync"
+	"sync/atomic"
 
 	"github.com/google/uuid"
 	"github.com/oddin-gg/gosdk/internal/api"
 	"github.com/oddin-gg/gosdk/internal/cache"
 	"github.com/oddin-gg/gosdk/internal/factory"
 	"github.com/oddin-gg/gosdk/internal/feed"
+	"github.com/oddin-gg/gosdk/internal/journal"
 	"github.com/oddin-gg/gosdk/internal/market"
 	"github.com/oddin-gg/gosdk/internal/producer"
 	"github.com/oddin-gg/gosdk/internal/recovery"
@@ -36,11 +40,15 @@ type oddsFeedImpl struct {
 	apiClient                *api.Client
 	opened                   bool
 	cacheManager             *cache.Manager
-	rabbitMQClient           *feed.Client
+	transport                protocols.Transport
+	journal                  *journal.Writer
 	feedMessageFactory       *factory.FeedMessageFactory
 	sessionMap               map[uuid.UUID]*sessionData
 	msgCh                    chan protocols.GlobalMessage
 	closeCh                  chan bool
+	forwardersWg             sync.WaitGroup
+	closeOnce                sync.Once
+	listenerErrors           atomic.Uint64
 }
 
 func (o *oddsFeedImpl) SessionBuilder() (protocols.OddsFeedSessionBuilder, error) {
@@ -51,7 +59,8 @@ func (o *oddsFeedImpl) SessionBuilder() (protocols.OddsFeedSessionBuilder, error
 	return &builderImpl{
 		oddsFeedConfiguration:    o.cfg,
 		sessionMap:               o.sessionMap,
-		rabbitMQClient:           o.rabbitMQClient,
+		transport:                o.transport,
+		journal:                  o.journal,
 		producerManager:          o.producerManager,
 		cacheManager:             o.cacheManager,
 		feedMessageFactory:       o.feedMessageFactory,
@@ -109,6 +118,12 @@ func (o *oddsFeedImpl) ReplayManager() (protocols.ReplayManager, error) {
 }
 
 func (o *oddsFeedImpl) Close() error {
+	// Feed can be closed by the user and by the context passed to OpenWithContext
+	o.closeOnce.Do(o.close)
+	return nil
+}
+
+func (o *oddsFeedImpl) close() {
 	o.opened = false
 	if o.recoveryManager != nil {
 		o.recoveryManager.Close()
@@ -126,31 +141,47 @@ func (o *oddsFeedImpl) Close() error {
 
 	if o.closeCh != nil {
 		close(o.closeCh)
+		// msgCh can be closed only once nothing sends to it
+		o.forwardersWg.Wait()
+	}
+
+	if o.producerManager != nil {
+		o.producerManager.Close()
 	}
 
 	if o.cacheManager != nil {
 		o.cacheManager.Close()
 	}
 
-	if o.rabbitMQClient != nil {
-		o.rabbitMQClient.Close()
+	// Sessions stopped their consumers above, so the journal has all received messages before transport goes down
+	if o.journal != nil {
+		err := o.journal.Close()
+		if err != nil {
+			o.logger.WithError(err).Error("failed to close journal")
+		}
+	}
+
+	if o.transport != nil {
+		o.transport.Close()
 	}
 
 	if o.msgCh != nil {
 		close(o.msgCh)
 	}
-
-	return nil
 }
 
 func (o *oddsFeedImpl) Open() (protocols.GlobalMessageDelivery, error) {
+	return o.OpenWithContext(context.Background())
+}
+
+func (o *oddsFeedImpl) OpenWithContext(ctx context.Context) (protocols.GlobalMessageDelivery, error) {
 	if o.opened {
 		return nil, errors.New("already opened")
 	}
 
 	o.opened = true
 
-	err := o.producerManager.Open()
+	err := o.producerManager.Open(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -192,6 +223,7 @@ func (o *oddsFeedImpl) Open() (protocols.GlobalMessageDelivery, error) {
 		keyMap[key] = keyData{
 			messageInterest: *value.messageInterest,
 			eventIDs:        value.eventIDs,
+			sportIDs:        value.sportIDs,
 		}
 
 		if value.session.IsReplay() {
@@ -212,7 +244,9 @@ func (o *oddsFeedImpl) Open() (protocols.GlobalMessageDelivery, error) {
 	// Add system alive only interest if needed
 	if !hasAliveMessageInterest && !replayOnly {
 		session := newSession(
-			o.rabbitMQClient,
+			o.cfg,
+			o.transport,
+			o.journal,
 			o.producerManager,
 			o.cacheManager,
 			o.feedMessageFactory,
@@ -220,6 +254,7 @@ func (o *oddsFeedImpl) Open() (protocols.GlobalMessageDelivery, error) {
 			o.cfg.ExchangeName(),
 			o.cfg.SportIDPrefix(),
 			false,
+			sessionOptions{index: len(o.sessionMap) + 1},
 			o.logger,
 		)
 		sessionData := &sessionData{
@@ -230,12 +265,13 @@ func (o *oddsFeedImpl) Open() (protocols.GlobalMessageDelivery, error) {
 		o.sessionMap[session.ID()] = sessionData
 		go func() {
 			// no-op message consumption
-			for range session.RespCh() {
+			for msg := range session.RespCh() {
+				_ = msg.Ack()
 			}
 		}()
 	}
 
-	err = o.rabbitMQClient.Open()
+	err = o.transport.Open()
 	if err != nil {
 		return nil, err
 	}
@@ -244,10 +280,10 @@ func (o *oddsFeedImpl) Open() (protocols.GlobalMessageDelivery, error) {
 		var err error
 		if value.isAliveOnly {
 			messageInterest := protocols.SystemAliveOnly
-			err = value.session.Open([]string{string(protocols.SystemAliveOnly)}, &messageInterest, false)
+			err = value.session.Open(ctx, []string{string(protocols.SystemAliveOnly)}, &messageInterest, false)
 		} else {
 			routingKeys := sessionRoutingKeys[value.session.ID()]
-			err = value.session.Open(routingKeys, value.messageInterest, o.cfg.ReportExtendedData())
+			err = value.session.Open(ctx, routingKeys, value.messageInterest, o.cfg.ReportExtendedData())
 		}
 
 		if err != nil {
@@ -255,53 +291,130 @@ func (o *oddsFeedImpl) Open() (protocols.GlobalMessageDelivery, error) {
 		}
 	}
 
-	recoveryCh, err := o.recoveryManager.Open()
+	recoveryCh, err := o.recoveryManager.Open(ctx)
 	if err != nil {
 		return nil, err
 	}
 	apiCh := o.apiClient.Open()
 
-	o.msgCh = make(chan protocols.GlobalMessage)
+	o.msgCh = make(chan protocols.GlobalMessage, o.cfg.ChannelBufferSize())
 	o.closeCh = make(chan bool, 1)
-	go func() {
-		for {
-			select {
-			case recoveryMsg := <-recoveryCh:
-				if !o.opened {
-					return
-				}
+	o.startForwarder(func() bool {
+		select {
+		case recoveryMsg, ok := <-recoveryCh:
+			return ok && o.opened && o.forward(protocols.GlobalMessage{
+				Recovery: &recoveryMsg,
+			})
+
+		case <-o.closeCh:
+			return false
+		}
+	})
 
-				o.msgCh <- protocols.GlobalMessage{
-					Recovery: &recoveryMsg,
-				}
+	o.startForwarder(func() bool {
+		select {
+		case apiMsg := <-apiCh:
+			if !o.opened {
+				return false
+			}
 
-			case <-o.closeCh:
-				return
+			if !o.cfg.ReportExtendedData() {
+				return true
 			}
+
+			return o.forward(protocols.GlobalMessage{
+				APIMessage: &apiMsg,
+			})
+
+		case <-o.closeCh:
+			return false
 		}
-	}()
+	})
 
-	go func() {
-		for {
+	if ctx.Done() != nil {
+		go func() {
 			select {
-			case apiMsg := <-apiCh:
-				if !o.opened {
-					return
-				}
+			case <-ctx.Done():
+				o.logger.WithError(ctx.Err()).Info("context done, closing feed")
+				_ = o.Close()
 
-				if o.cfg.ReportExtendedData() {
-					o.msgCh <- protocols.GlobalMessage{
-						APIMessage: &apiMsg,
-					}
+			case <-o.closeCh:
+			}
+		}()
+	}
+
+	connectionCh := o.transport.ConnectionStatusCh()
+	o.startForwarder(func() bool {
+		select {
+		case status := <-connectionCh:
+			if !o.opened {
+				return false
+			}
+
+			if status.State() == protocols.ConnectedConnectionState {
+				for _, data := range o.sessionMap {
+					data.session.connectionRestored()
 				}
+			}
 
-			case <-o.closeCh:
-				return
+			return o.forward(protocols.GlobalMessage{
+				ConnectionStatus: status,
+			})
+
+		case <-o.closeCh:
+			return false
+		}
+	})
+
+	return o.msgCh, nil
+}
+
+// startForwarder runs forward until it returns false, close waits for forwarders before closing msgCh
+func (o *oddsFeedImpl) startForwarder(forward func() bool) {
+	o.forwardersWg.Add(1)
+	go func() {
+		defer o.forwardersWg.Done()
+
+		for forward() {
+		}
+	}()
+}
+
+// forward delivers msg to the global channel, false is returned when the feed is closing
+func (o *oddsFeedImpl) forward(msg protocols.GlobalMessage) bool {
+	select {
+	case o.msgCh <- msg:
+		return true
+	case <-o.closeCh:
+		return false
+	}
+}
+
+func (o *oddsFeedImpl) OpenWithListener(listener protocols.GlobalListener) error {
+	if listener == nil {
+		return errors.New("listener is not specified")
+	}
+
+	msgCh, err := o.Open()
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		for msg := range msgCh {
+			err := dispatchGlobalMessage(listener, msg)
+			if err != nil {
+				o.listenerErrors.Add(1)
+				o.logger.WithError(err).Error("global listener failed to process message")
 			}
 		}
 	}()
 
-	return o.msgCh, nil
+	return nil
+}
+
+func (o *oddsFeedImpl) ListenerErrors() uint64 {
+	return o.listenerErrors.Load()
 }
 
 func (o *oddsFeedImpl) init() error {
@@ -341,6 +454,7 @@ func (o *oddsFeedImpl) init() error {
 	o.feedMessageFactory = factory.NewFeedMessageFactory(
 		entityFactory,
 		marketFactory,
+		marketDescriptionFactory,
 		o.producerManager,
 		o.cfg,
 	)
@@ -349,13 +463,21 @@ func (o *oddsFeedImpl) init() error {
 		o.cfg,
 		o.producerManager,
 		o.apiClient,
+		o.feedMessageFactory,
 		o.logger,
 	)
 	o.marketDescriptionManager = market.NewManager(o.cacheManager, marketDescriptionFactory, o.cfg)
 	o.sportsInfoManager = sport.NewManager(entityFactory, o.apiClient, o.cacheManager, o.cfg)
 	o.replayManager = replay.NewManager(o.apiClient, o.cfg, o.sportsInfoManager)
 
-	o.rabbitMQClient = feed.NewClient(o.cfg, o.whoAmIManager, o.logger)
+	o.transport = o.cfg.Transport()
+	if o.transport == nil {
+		o.transport = feed.NewClient(o.cfg, o.whoAmIManager, o.logger)
+	}
+
+	if o.cfg.Journal() != nil {
+		o.journal = journal.NewWriter(*o.cfg.Journal(), o.cfg.Clock())
+	}
 
 	o.feedInitialized = true
 
@@ -392,29 +514,29 @@ func (o *oddsFeedImpl) generateKeys(sessionsData map[uuid.UUID]keyData) (map[uui
 		sessionRoutingKeysMap := make(map[string]struct{})
 
 		basicRoutingKeys := make([]string, 0)
-		if value.messageInterest == protocols.SpecifiedMatchesOnlyMessageInterest {
+		switch value.messageInterest {
+		case protocols.SpecifiedMatchesOnlyMessageInterest:
 			for urn := range value.eventIDs {
-				basicRoutingKeys = append(basicRoutingKeys, fmt.Sprintf("#.%s:%s.%d", urn.Prefix, urn.Type, urn.ID))
+				basicRoutingKeys = append(basicRoutingKeys, eventRoutingKey(urn))
 			}
-		} else {
+		case protocols.SpecifiedSportsOnlyMessageInterest:
+			for urn := range value.sportIDs {
+				basicRoutingKeys = append(basicRoutingKeys, fmt.Sprintf("*.*.*.*.%d.*.*", urn.ID))
+			}
+		case protocols.SpecifiedTournamentsOnlyMessageInterest:
+			// Routing key does not contain tournament, messages are filtered by session
+			basicRoutingKeys = append(basicRoutingKeys, string(protocols.AllMessageInterest))
+		default:
 			basicRoutingKeys = append(basicRoutingKeys, string(value.messageInterest))
 		}
 
 		for i := range basicRoutingKeys {
-			basicRoutingKey := basicRoutingKeys[i]
-
-			if o.cfg.SdkNodeID() != nil {
-				sessionRoutingKeysMap[fmt.Sprintf("%s.%d.#", basicRoutingKey, *o.cfg.SdkNodeID())] = struct{}{}
-				basicRoutingKey = fmt.Sprintf("%s.-.#", basicRoutingKey)
-			} else {
-				basicRoutingKey = fmt.Sprintf("%s.#", basicRoutingKey)
+			for _, routingKey := range nodeRoutingKeys(o.cfg.SdkNodeID(), basicRoutingKeys[i]) {
+				sessionRoutingKeysMap[routingKey] = struct{}{}
 			}
 
-			if bothLowAndHigh && value.messageInterest == protocols.LowPriorityOnlyMessageInterest {
-				sessionRoutingKeysMap[basicRoutingKey] = struct{}{}
-			} else {
+			if !bothLowAndHigh || value.messageInterest != protocols.LowPriorityOnlyMessageInterest {
 				sessionRoutingKeysMap[snapshotRoutingKey] = struct{}{}
-				sessionRoutingKeysMap[basicRoutingKey] = struct{}{}
 			}
 		}
 
@@ -441,6 +563,7 @@ func (o *oddsFeedImpl) validateInterestCombination(sessionsData map[uuid.UUID]ke
 	var hasAll bool
 	var hasPriority bool
 	var hasMessages bool
+	var hasScope bool
 	for _, value := range sessionsData {
 		userInterests[value.messageInterest] = struct{}{}
 
@@ -455,6 +578,10 @@ func (o *oddsFeedImpl) validateInterestCombination(sessionsData map[uuid.UUID]ke
 		if value.messageInterest == protocols.PrematchOnlyMessageInterest || value.messageInterest == protocols.LiveOnlyMessageInterest {
 			hasMessages = true
 		}
+
+		if value.messageInterest == protocols.SpecifiedSportsOnlyMessageInterest || value.messageInterest == protocols.SpecifiedTournamentsOnlyMessageInterest {
+			hasScope = true
+		}
 	}
 
 	switch {
@@ -464,11 +591,30 @@ func (o *oddsFeedImpl) validateInterestCombination(sessionsData map[uuid.UUID]ke
 		return errors.New("all messages can be used only for single session configuration")
 	case hasPriority && hasMessages:
 		return errors.New("cannot combine priority messages with other types")
+	case hasPriority && hasScope:
+		return errors.New("cannot combine priority messages with sport or tournament messages")
 	}
 
 	return nil
 }
 
+// eventRoutingKey matches all messages of the event
+func eventRoutingKey(eventID protocols.URN) string {
+	return fmt.Sprintf("#.%s:%s.%d", eventID.Prefix, eventID.Type, eventID.ID)
+}
+
+// nodeRoutingKeys adds node id part to the routing key, messages for all nodes are matched as well
+func nodeRoutingKeys(nodeID *int, basicRoutingKey string) []string {
+	if nodeID == nil {
+		return []string{fmt.Sprintf("%s.#", basicRoutingKey)}
+	}
+
+	return []string{
+		fmt.Sprintf("%s.%d.#", basicRoutingKey, *nodeID),
+		fmt.Sprintf("%s.-.#", basicRoutingKey),
+	}
+}
+
 // NewOddsFeed ...
 func NewOddsFeed(configuration protocols.OddsFeedConfiguration) protocols.OddsFeed {
 	return &oddsFeedImpl{
@@ -480,4 +626,5 @@ func NewOddsFeed(configuration protocols.OddsFeedConfiguration) protocols.OddsFe
 type keyData struct {
 	messageInterest protocols.MessageInterest
 	eventIDs        map[protocols.URN]struct{}
+	sportIDs        map[protocols.URN]struct{}
 }
@@ -7,6 +7,7 @@ require (
 	github.com/patrickmn/go-cache v2.1.0+incompatible
 	github.com/rabbitmq/amqp091-go v1.9.0
 	github.com/sirupsen/logrus v1.9.3
+	golang.org/x/sys v0.11.0
 	golang.org/x/vuln v1.0.4
 	honnef.co/go/tools v0.4.6
 )
@@ -16,6 +17,5 @@ require (
 	golang.org/x/exp/typeparams v0.0.0-20221208152030-732eee02a75a // indirect
 	golang.org/x/mod v0.14.0 // indirect
 	golang.org/x/sync v0.6.0 // indirect
-	golang.org/x/sys v0.11.0 // indirect
 	golang.org/x/tools v0.17.0 // indirect
 )
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"encoding/xml"
 	"fmt"
 	"io"
@@ -15,11 +16,10 @@ import (
 )
 
 const (
-	apiVersion     = "v1"
-	timeoutSeconds = 10
-	timeLayout     = "2006-01-02"
-	retryCount     = 3
-	retryDelay     = 10 * time.Second
+	apiVersion = "v1"
+	timeLayout = "2006-01-02"
+	retryCount = 3
+	retryDelay = 10 * time.Second
 )
 
 // Observer ...
@@ -38,16 +38,16 @@ type Client struct {
 }
 
 // FetchWhoAmI ...
-func (c *Client) FetchWhoAmI() (*data.WhoAMI, error) {
+func (c *Client) FetchWhoAmI(ctx context.Context) (*data.WhoAMI, error) {
 	var resp data.WhoAMI
-	err := c.fetchData("/users/whoami", &resp, nil)
+	err := c.fetchData(ctx, "/users/whoami", &resp, nil)
 	return &resp, err
 }
 
 // FetchProducers ...
-func (c *Client) FetchProducers() ([]data.Producer, error) {
+func (c *Client) FetchProducers(ctx context.Context) ([]data.Producer, error) {
 	var resp data.ProducersResponse
-	err := c.fetchData("/descriptions/producers", &resp, nil)
+	err := c.fetchData(ctx, "/descriptions/producers", &resp, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -56,9 +56,9 @@ func (c *Client) FetchProducers() ([]data.Producer, error) {
 }
 
 // FetchSports ...
-func (c *Client) FetchSports(locale protocols.Locale) ([]data.Sport, error) {
+func (c *Client) FetchSports(ctx context.Context, locale protocols.Locale) ([]data.Sport, error) {
 	var resp data.SportsResponse
-	err := c.fetchData(fmt.Sprintf("/sports/%s/sports", locale), &resp, &locale)
+	err := c.fetchData(ctx, fmt.Sprintf("/sports/%s/sports", locale), &resp, &locale)
 	if err != nil {
 		return nil, err
 	}
@@ -67,9 +67,9 @@ func (c *Client) FetchSports(locale protocols.Locale) ([]data.Sport, error) {
 }
 
 // FetchMatchStatusDescriptions ...
-func (c *Client) FetchMatchStatusDescriptions(locale protocols.Locale) ([]data.MatchStatus, error) {
+func (c *Client) FetchMatchStatusDescriptions(ctx context.Context, locale protocols.Locale) ([]data.MatchStatus, error) {
 	var resp data.MatchStatusDescriptionResponse
-	err := c.fetchData(fmt.Sprintf("/descriptions/%s/match_status", locale), &resp, &locale)
+	err := c.fetchData(ctx, fmt.Sprintf("/descriptions/%s/match_status", locale), &resp, &locale)
 	if err != nil {
 		return nil, err
 	}
@@ -78,7 +78,7 @@ func (c *Client) FetchMatchStatusDescriptions(locale protocols.Locale) ([]data.M
 }
 
 // FetchFixtureChanges ...
-func (c *Client) FetchFixtureChanges(locale protocols.Locale, after time.Time) ([]data.FixtureChange, error) {
+func (c *Client) FetchFixtureChanges(ctx context.Context, locale protocols.Locale, after time.Time) ([]data.FixtureChange, error) {
 	path := fmt.Sprintf("/sports/%s/fixtures/changes", locale)
 
 	if !after.IsZero() {
@@ -86,7 +86,7 @@ func (c *Client) FetchFixtureChanges(locale protocols.Locale, after time.Time) (
 	}
 
 	var resp data.FixtureChangesResponse
-	err := c.fetchData(path, &resp, &locale)
+	err := c.fetchData(ctx, path, &resp, &locale)
 	if err != nil {
 		return nil, err
 	}
@@ -95,9 +95,9 @@ func (c *Client) FetchFixtureChanges(locale protocols.Locale, after time.Time) (
 }
 
 // FetchFixture ...
-func (c *Client) FetchFixture(id protocols.URN, locale protocols.Locale) (*data.Fixture, error) {
+func (c *Client) FetchFixture(ctx context.Context, id protocols.URN, locale protocols.Locale) (*data.Fixture, error) {
 	var resp data.FixtureResponse
-	err := c.fetchData(fmt.Sprintf("/sports/%s/sport_events/%s/fixture", locale, id.ToString()), &resp, &locale)
+	err := c.fetchData(ctx, fmt.Sprintf("/sports/%s/sport_events/%s/fixture", locale, id.ToString()), &resp, &locale)
 	if err != nil {
 		return nil, err
 	}
@@ -106,9 +106,9 @@ func (c *Client) FetchFixture(id protocols.URN, locale protocols.Locale) (*data.
 }
 
 // FetchSchedule ...
-func (c *Client) FetchSchedule(startIndex, limit uint, locale protocols.Locale) ([]data.SportEvent, error) {
+func (c *Client) FetchSchedule(ctx context.Context, startIndex, limit uint, locale protocols.Locale) ([]data.SportEvent, error) {
 	var resp data.ScheduleResponse
-	err := c.fetchData(fmt.Sprintf("/sports/%s/schedules/pre/schedule?start=%d&limit=%d", locale, startIndex, limit), &resp, &locale)
+	err := c.fetchData(ctx, fmt.Sprintf("/sports/%s/schedules/pre/schedule?start=%d&limit=%d", locale, startIndex, limit), &resp, &locale)
 	if err != nil {
 		return nil, err
 	}
@@ -117,9 +117,9 @@ func (c *Client) FetchSchedule(startIndex, limit uint, locale protocols.Locale)
 }
 
 // FetchTournaments ...
-func (c *Client) FetchTournaments(sportID protocols.URN, locale protocols.Locale) ([]data.Tournament, error) {
+func (c *Client) FetchTournaments(ctx context.Context, sportID protocols.URN, locale protocols.Locale) ([]data.Tournament, error) {
 	var resp data.SportTournamentsResponse
-	err := c.fetchData(fmt.Sprintf("/sports/%s/sports/%s/tournaments", locale, sportID.ToString()), &resp, &locale)
+	err := c.fetchData(ctx, fmt.Sprintf("/sports/%s/sports/%s/tournaments", locale, sportID.ToString()), &resp, &locale)
 	if err != nil {
 		return nil, err
 	}
@@ -133,9 +133,9 @@ func (c *Client) FetchTournaments(sportID protocols.URN, locale protocols.Locale
 }
 
 // FetchTournament ...
-func (c *Client) FetchTournament(id protocols.URN, locale protocols.Locale) (*data.TournamentExtended, error) {
+func (c *Client) FetchTournament(ctx context.Context, id protocols.URN, locale protocols.Locale) (*data.TournamentExtended, error) {
 	var resp data.SportTournamentInfoResponse
-	err := c.fetchData(fmt.Sprintf("/sports/%s/tournaments/%s/info", locale, id.ToString()), &resp, &locale)
+	err := c.fetchData(ctx, fmt.Sprintf("/sports/%s/tournaments/%s/info", locale, id.ToString()), &resp, &locale)
 	if err != nil {
 		return nil, err
 	}
@@ -144,8 +144,8 @@ func (c *Client) FetchTournament(id protocols.URN, locale protocols.Locale) (*da
 }
 
 // FetchCompetitorProfile ...
-func (c *Client) FetchCompetitorProfile(id protocols.URN, locale protocols.Locale) (*data.TeamExtended, error) {
-	resp, err := c.FetchCompetitorProfileWithPlayers(id, locale)
+func (c *Client) FetchCompetitorProfile(ctx context.Context, id protocols.URN, locale protocols.Locale) (*data.TeamExtended, error) {
+	resp, err := c.FetchCompetitorProfileWithPlayers(ctx, id, locale)
 	if err != nil {
 		return nil, err
 	}
@@ -154,9 +154,9 @@ func (c *Client) FetchCompetitorProfile(id protocols.URN, locale protocols.Local
 }
 
 // FetchCompetitorProfileWithPlayers ...
-func (c *Client) FetchCompetitorProfileWithPlayers(id protocols.URN, locale protocols.Locale) (*data.CompetitorResponse, error) {
+func (c *Client) FetchCompetitorProfileWithPlayers(ctx context.Context, id protocols.URN, locale protocols.Locale) (*data.CompetitorResponse, error) {
 	var resp data.CompetitorResponse
-	err := c.fetchData(fmt.Sprintf("/sports/%s/competitors/%s/profile", locale, id.ToString()), &resp, &locale)
+	err := c.fetchData(ctx, fmt.Sprintf("/sports/%s/competitors/%s/profile", locale, id.ToString()), &resp, &locale)
 	if err != nil {
 		return nil, err
 	}
@@ -165,9 +165,9 @@ func (c *Client) FetchCompetitorProfileWithPlayers(id protocols.URN, locale prot
 }
 
 // FetchMatchSummary ...
-func (c *Client) FetchMatchSummary(id protocols.URN, locale protocols.Locale) (*data.MatchSummaryResponse, error) {
+func (c *Client) FetchMatchSummary(ctx context.Context, id protocols.URN, locale protocols.Locale) (*data.MatchSummaryResponse, error) {
 	var resp data.MatchSummaryResponse
-	err := c.fetchData(fmt.Sprintf("/sports/%s/sport_events/%s/summary", locale, id.ToString()), &resp, &locale)
+	err := c.fetchData(ctx, fmt.Sprintf("/sports/%s/sport_events/%s/summary", locale, id.ToString()), &resp, &locale)
 	if err != nil {
 		return nil, err
 	}
@@ -176,9 +176,9 @@ func (c *Client) FetchMatchSummary(id protocols.URN, locale protocols.Locale) (*
 }
 
 // FetchLiveMatches ...
-func (c *Client) FetchLiveMatches(locale protocols.Locale) ([]data.SportEvent, error) {
+func (c *Client) FetchLiveMatches(ctx context.Context, locale protocols.Locale) ([]data.SportEvent, error) {
 	var resp data.ScheduleResponse
-	err := c.fetchData(fmt.Sprintf("/sports/%s/schedules/live/schedule", locale), &resp, &locale)
+	err := c.fetchData(ctx, fmt.Sprintf("/sports/%s/schedules/live/schedule", locale), &resp, &locale)
 	if err != nil {
 		return nil, err
 	}
@@ -187,9 +187,9 @@ func (c *Client) FetchLiveMatches(locale protocols.Locale) ([]data.SportEvent, e
 }
 
 // FetchMatches ...
-func (c *Client) FetchMatches(t time.Time, locale protocols.Locale) ([]data.SportEvent, error) {
+func (c *Client) FetchMatches(ctx context.Context, t time.Time, locale protocols.Locale) ([]data.SportEvent, error) {
 	var resp data.ScheduleResponse
-	err := c.fetchData(fmt.Sprintf("/sports/%s/schedules/%s/schedule", locale, t.Format(timeLayout)), &resp, &locale)
+	err := c.fetchData(ctx, fmt.Sprintf("/sports/%s/schedules/%s/schedule", locale, t.Format(timeLayout)), &resp, &locale)
 	if err != nil {
 		return nil, err
 	}
@@ -198,9 +198,9 @@ func (c *Client) FetchMatches(t time.Time, locale protocols.Locale) ([]data.Spor
 }
 
 // FetchMarketDescriptions ...
-func (c *Client) FetchMarketDescriptions(locale protocols.Locale) ([]data.MarketDescription, error) {
+func (c *Client) FetchMarketDescriptions(ctx context.Context, locale protocols.Locale) ([]data.MarketDescription, error) {
 	var resp data.MarketDescriptionResponse
-	err := c.fetchData(fmt.Sprintf("/descriptions/%s/markets", locale), &resp, &locale)
+	err := c.fetchData(ctx, fmt.Sprintf("/descriptions/%s/markets", locale), &resp, &locale)
 	if err != nil {
 		return nil, err
 	}
@@ -210,12 +210,14 @@ func (c *Client) FetchMarketDescriptions(locale protocols.Locale) ([]data.Market
 
 // FetchMarketDescriptionsWithDynamicOutcomes ...
 func (c *Client) FetchMarketDescriptionsWithDynamicOutcomes(
+	ctx context.Context,
 	marketTypeID uint,
 	marketVariant string,
 	locale protocols.Locale,
 ) ([]data.MarketDescription, error) {
 	var resp data.MarketDescriptionResponse
 	err := c.fetchData(
+		ctx,
 		fmt.Sprintf("/descriptions/%s/markets/%d/variants/%s", locale, marketTypeID, marketVariant),
 		&resp,
 		&locale,
@@ -228,18 +230,18 @@ func (c *Client) FetchMarketDescriptionsWithDynamicOutcomes(
 }
 
 // FetchMarketVoidReasons ...
-func (c *Client) FetchMarketVoidReasons() ([]data.MarketVoidReasons, error) {
+func (c *Client) FetchMarketVoidReasons(ctx context.Context) ([]data.MarketVoidReasons, error) {
 	var resp data.MarketVoidReasonsResponse
-	if err := c.fetchData("/descriptions/void_reasons", &resp, nil); err != nil {
+	if err := c.fetchData(ctx, "/descriptions/void_reasons", &resp, nil); err != nil {
 		return nil, err
 	}
 	return resp.VoidReasons, nil
 }
 
 // FetchPlayerProfile fetch player's profile
-func (c *Client) FetchPlayerProfile(playerID string, locale protocols.Locale) (*data.PlayerProfile, error) {
+func (c *Client) FetchPlayerProfile(ctx context.Context, playerID string, locale protocols.Locale) (*data.PlayerProfile, error) {
 	var resp data.PlayerProfile
-	err := c.fetchData(fmt.Sprintf("/sports/%s/players/%s/profile", locale, playerID), &resp, &locale)
+	err := c.fetchData(ctx, fmt.Sprintf("/sports/%s/players/%s/profile", locale, playerID), &resp, &locale)
 	if err != nil {
 		return nil, err
 	}
@@ -247,13 +249,13 @@ func (c *Client) FetchPlayerProfile(playerID string, locale protocols.Locale) (*
 }
 
 // PostEventStatefulRecovery ...
-func (c *Client) PostEventStatefulRecovery(producerName string, eventID protocols.URN, requestID uint, nodeID *int) (bool, error) {
+func (c *Client) PostEventStatefulRecovery(ctx context.Context, producerName string, eventID protocols.URN, requestID uint, nodeID *int) (bool, error) {
 	path := fmt.Sprintf("/%s/stateful_messages/events/%s/initiate_request?request_id=%d", producerName, eventID.ToString(), requestID)
 	if nodeID != nil {
 		path = fmt.Sprintf("%s&node_id=%d", path, *nodeID)
 	}
 
-	res, err := c.do(http.MethodPost, path)
+	res, err := c.do(ctx, http.MethodPost, path)
 	if err != nil {
 		return false, err
 	}
@@ -263,13 +265,13 @@ func (c *Client) PostEventStatefulRecovery(producerName string, eventID protocol
 }
 
 // PostEventOddsRecovery ...
-func (c *Client) PostEventOddsRecovery(producerName string, eventID protocols.URN, requestID uint, nodeID *int) (bool, error) {
+func (c *Client) PostEventOddsRecovery(ctx context.Context, producerName string, eventID protocols.URN, requestID uint, nodeID *int) (bool, error) {
 	path := fmt.Sprintf("/%s/odds/events/%s/initiate_request?request_id=%d", producerName, eventID.ToString(), requestID)
 	if nodeID != nil {
 		path = fmt.Sprintf("%s&node_id=%d", path, *nodeID)
 	}
 
-	res, err := c.do(http.MethodPost, path)
+	res, err := c.do(ctx, http.MethodPost, path)
 	if err != nil {
 		return false, err
 	}
@@ -279,7 +281,7 @@ func (c *Client) PostEventOddsRecovery(producerName string, eventID protocols.UR
 }
 
 // PostRecovery ...
-func (c *Client) PostRecovery(producerName string, requestID uint, nodeID *int, after time.Time) (bool, error) {
+func (c *Client) PostRecovery(ctx context.Context, producerName string, requestID uint, nodeID *int, after time.Time) (bool, error) {
 	path := fmt.Sprintf("/%s/recovery/initiate_request?request_id=%d", producerName, requestID)
 	if nodeID != nil {
 		path = fmt.Sprintf("%s&node_id=%d", path, *nodeID)
@@ -289,7 +291,7 @@ func (c *Client) PostRecovery(producerName string, requestID uint, nodeID *int,
 		path = fmt.Sprintf("%s&after=%d", path, after.UnixNano()/1e6)
 	}
 
-	res, err := c.do(http.MethodPost, path)
+	res, err := c.do(ctx, http.MethodPost, path)
 	if err != nil {
 		return false, err
 	}
@@ -299,13 +301,13 @@ func (c *Client) PostRecovery(producerName string, requestID uint, nodeID *int,
 }
 
 // PostReplayClear ...
-func (c *Client) PostReplayClear(nodeID *int) (bool, error) {
+func (c *Client) PostReplayClear(ctx context.Context, nodeID *int) (bool, error) {
 	path := "/replay/clear"
 	if nodeID != nil {
 		path = fmt.Sprintf("%s?node_id=%d", path, *nodeID)
 	}
 
-	res, err := c.do(http.MethodPost, path)
+	res, err := c.do(ctx, http.MethodPost, path)
 	if err != nil {
 		return false, err
 	}
@@ -315,13 +317,13 @@ func (c *Client) PostReplayClear(nodeID *int) (bool, error) {
 }
 
 // PostReplayStop ...
-func (c *Client) PostReplayStop(nodeID *int) (bool, error) {
+func (c *Client) PostReplayStop(ctx context.Context, nodeID *int) (bool, error) {
 	path := "/replay/stop"
 	if nodeID != nil {
 		path = fmt.Sprintf("%s?node_id=%d", path, *nodeID)
 	}
 
-	res, err := c.do(http.MethodPost, path)
+	res, err := c.do(ctx, http.MethodPost, path)
 	if err != nil {
 		return false, err
 	}
@@ -331,14 +333,14 @@ func (c *Client) PostReplayStop(nodeID *int) (bool, error) {
 }
 
 // FetchReplaySetContent ...
-func (c *Client) FetchReplaySetContent(nodeID *int) ([]data.ReplayEvent, error) {
+func (c *Client) FetchReplaySetContent(ctx context.Context, nodeID *int) ([]data.ReplayEvent, error) {
 	path := "/replay"
 	if nodeID != nil {
 		path = fmt.Sprintf("%s?node_id=%d", path, *nodeID)
 	}
 
 	var res data.ReplayResponse
-	err := c.fetchData(path, &res, nil)
+	err := c.fetchData(ctx, path, &res, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -347,13 +349,13 @@ func (c *Client) FetchReplaySetContent(nodeID *int) ([]data.ReplayEvent, error)
 }
 
 // PutReplayEvent ...
-func (c *Client) PutReplayEvent(eventID protocols.URN, nodeID *int) (bool, error) {
+func (c *Client) PutReplayEvent(ctx context.Context, eventID protocols.URN, nodeID *int) (bool, error) {
 	path := fmt.Sprintf("/replay/events/%s", eventID.ToString())
 	if nodeID != nil {
 		path = fmt.Sprintf("%s?node_id=%d", path, *nodeID)
 	}
 
-	res, err := c.do(http.MethodPut, path)
+	res, err := c.do(ctx, http.MethodPut, path)
 	if err != nil {
 		return false, err
 	}
@@ -363,13 +365,13 @@ func (c *Client) PutReplayEvent(eventID protocols.URN, nodeID *int) (bool, error
 }
 
 // DeleteReplayEvent ...
-func (c *Client) DeleteReplayEvent(eventID protocols.URN, nodeID *int) (bool, error) {
+func (c *Client) DeleteReplayEvent(ctx context.Context, eventID protocols.URN, nodeID *int) (bool, error) {
 	path := fmt.Sprintf("/replay/events/%s", eventID.ToString())
 	if nodeID != nil {
 		path = fmt.Sprintf("%s?node_id=%d", path, *nodeID)
 	}
 
-	res, err := c.do(http.MethodDelete, path)
+	res, err := c.do(ctx, http.MethodDelete, path)
 	if err != nil {
 		return false, err
 	}
@@ -379,7 +381,7 @@ func (c *Client) DeleteReplayEvent(eventID protocols.URN, nodeID *int) (bool, er
 }
 
 // PostReplayStart ...
-func (c *Client) PostReplayStart(nodeID *int, speed *int, maxDelay *int, useReplayTimestamp *bool, product *string, runParallel *bool) (bool, error) {
+func (c *Client) PostReplayStart(ctx context.Context, nodeID *int, speed *int, maxDelay *int, useReplayTimestamp *bool, product *string, runParallel *bool) (bool, error) {
 	queryParams := make(map[string]interface{})
 	if nodeID != nil {
 		queryParams["node_id"] = *nodeID
@@ -428,7 +430,7 @@ func (c *Client) PostReplayStart(nodeID *int, speed *int, maxDelay *int, useRepl
 		path = fmt.Sprintf("%s?%s", path, query)
 	}
 
-	res, err := c.do(http.MethodPost, path)
+	res, err := c.do(ctx, http.MethodPost, path)
 	if err != nil {
 		return false, err
 	}
@@ -439,7 +441,7 @@ func (c *Client) PostReplayStart(nodeID *int, speed *int, maxDelay *int, useRepl
 
 // Open for async processing
 func (c *Client) Open() <-chan protocols.Response {
-	c.msgCh = make(chan protocols.Response)
+	c.msgCh = make(chan protocols.Response, c.cfg.ChannelBufferSize())
 
 	return c.msgCh
 }
@@ -465,14 +467,14 @@ func (c *Client) Close() {
 	}
 }
 
-func (c *Client) makeRequest(path, method string) (*http.Request, error) {
+func (c *Client) makeRequest(ctx context.Context, path, method string) (*http.Request, error) {
 	basePath, err := c.cfg.APIURL()
 	if err != nil {
 		return nil, err
 	}
 
 	path = "https://" + basePath + "/" + apiVersion + path
-	request, err := http.NewRequest(method, path, nil)
+	request, err := http.NewRequestWithContext(ctx, method, path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -482,8 +484,8 @@ func (c *Client) makeRequest(path, method string) (*http.Request, error) {
 	return request, nil
 }
 
-func (c *Client) fetchData(path string, entity interface{}, locale *protocols.Locale) error {
-	resp, err := c.do(http.MethodGet, path)
+func (c *Client) fetchData(ctx context.Context, path string, entity interface{}, locale *protocols.Locale) error {
+	resp, err := c.do(ctx, http.MethodGet, path)
 	if err != nil {
 		return err
 	}
@@ -519,9 +521,9 @@ func (c *Client) fetchData(path string, entity interface{}, locale *protocols.Lo
 	return nil
 }
 
-func (c *Client) do(method, path string) (*http.Response, error) {
+func (c *Client) do(ctx context.Context, method, path string) (*http.Response, error) {
 	callback := func() (*http.Response, error) {
-		req, err := c.makeRequest(path, method)
+		req, err := c.makeRequest(ctx, path, method)
 		if err != nil {
 			return nil, err
 		}
@@ -550,8 +552,14 @@ func (c *Client) do(method, path string) (*http.Response, error) {
 			respOk = true
 
 			// Probably infrastructure error - retry
-		case resp.StatusCode >= 500:
-			time.Sleep(retryDelay)
+		case resp.StatusCode >= 500 && i < retryCount-1:
+			_ = resp.Body.Close()
+
+			select {
+			case <-time.After(retryDelay):
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
 		}
 
 		if respOk {
@@ -588,7 +596,7 @@ func New(cfg protocols.OddsFeedConfiguration) *Client {
 		cfg:       cfg,
 		observers: make([]Observer, 0),
 		httpClient: http.Client{
-			Timeout: timeoutSeconds * time.Second,
+			Timeout: cfg.APITimeout(),
 		},
 	}
 }
@@ -1,6 +1,7 @@
 package cache
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sync"
@@ -86,7 +87,7 @@ func (c *CompetitorCache) OnAPIResponse(apiResponse protocols.Response) {
 }
 
 // Competitor ...
-func (c *CompetitorCache) Competitor(id protocols.URN, locales []protocols.Locale) (*LocalizedCompetitor, error) {
+func (c *CompetitorCache) Competitor(ctx context.Context, id protocols.URN, locales []protocols.Locale) (*LocalizedCompetitor, error) {
 	item, _ := c.internalCache.Get(id.ToString())
 	result, ok := item.(*LocalizedCompetitor)
 
@@ -106,7 +107,7 @@ func (c *CompetitorCache) Competitor(id protocols.URN, locales []protocols.Local
 	}
 
 	if len(toFetchLocales) != 0 {
-		return c.loadAndCacheItem(id, toFetchLocales)
+		return c.loadAndCacheItem(ctx, id, toFetchLocales)
 	}
 
 	return result, nil
@@ -119,13 +120,13 @@ func (c *CompetitorCache) ClearCacheItem(id protocols.URN) {
 }
 
 // CompetitorIcon ...
-func (c *CompetitorCache) CompetitorIcon(id protocols.URN, locale protocols.Locale) (*string, error) {
+func (c *CompetitorCache) CompetitorIcon(ctx context.Context, id protocols.URN, locale protocols.Locale) (*string, error) {
 	icon, ok := c.iconCache.Get(id.ToString())
 	if ok {
 		return icon.(*string), nil
 	}
 
-	data, err := c.apiClient.FetchCompetitorProfile(id, locale)
+	data, err := c.apiClient.FetchCompetitorProfile(ctx, id, locale)
 	if err != nil {
 		return nil, err
 	}
@@ -212,10 +213,10 @@ func (c *CompetitorCache) refreshOrInsertItem(id protocols.URN, locale protocols
 	return nil
 }
 
-func (c *CompetitorCache) loadAndCacheItem(id protocols.URN, locales []protocols.Locale) (*LocalizedCompetitor, error) {
+func (c *CompetitorCache) loadAndCacheItem(ctx context.Context, id protocols.URN, locales []protocols.Locale) (*LocalizedCompetitor, error) {
 	for i := range locales {
 		locale := locales[i]
-		data, err := c.apiClient.FetchCompetitorProfileWithPlayers(id, locale)
+		data, err := c.apiClient.FetchCompetitorProfileWithPlayers(ctx, id, locale)
 		if err != nil {
 			return nil, err
 		}
@@ -297,6 +298,7 @@ func (l *LocalizedCompetitor) LocalizedName(locale protocols.Locale) (*string, e
 }
 
 type competitorImpl struct {
+	ctx             context.Context
 	id              protocols.URN
 	competitorCache *CompetitorCache
 	entityFactory   protocols.EntityFactory
@@ -308,7 +310,7 @@ func (c competitorImpl) IconPath() (*string, error) {
 		return nil, errors.New("missing locales")
 	}
 
-	item, err := c.competitorCache.CompetitorIcon(c.id, c.locales[0])
+	item, err := c.competitorCache.CompetitorIcon(c.ctx, c.id, c.locales[0])
 	if err != nil {
 		return nil, err
 	}
@@ -322,7 +324,7 @@ func (c competitorImpl) ID() protocols.URN {
 
 // Deprecated: do not use this method, it will be removed in future
 func (c competitorImpl) RefID() (*protocols.URN, error) {
-	item, err := c.competitorCache.Competitor(c.id, c.locales)
+	item, err := c.competitorCache.Competitor(c.ctx, c.id, c.locales)
 	if err != nil {
 		return nil, err
 	}
@@ -331,7 +333,7 @@ func (c competitorImpl) RefID() (*protocols.URN, error) {
 }
 
 func (c competitorImpl) Names() (map[protocols.Locale]string, error) {
-	item, err := c.competitorCache.Competitor(c.id, c.locales)
+	item, err := c.competitorCache.Competitor(c.ctx, c.id, c.locales)
 	if err != nil {
 		return nil, err
 	}
@@ -349,7 +351,7 @@ func (c competitorImpl) Names() (map[protocols.Locale]string, error) {
 }
 
 func (c competitorImpl) LocalizedName(locale protocols.Locale) (*string, error) {
-	item, err := c.competitorCache.Competitor(c.id, c.locales)
+	item, err := c.competitorCache.Competitor(c.ctx, c.id, c.locales)
 	if err != nil {
 		return nil, err
 	}
@@ -358,7 +360,7 @@ func (c competitorImpl) LocalizedName(locale protocols.Locale) (*string, error)
 }
 
 func (c competitorImpl) Abbreviations() (map[protocols.Locale]string, error) {
-	item, err := c.competitorCache.Competitor(c.id, c.locales)
+	item, err := c.competitorCache.Competitor(c.ctx, c.id, c.locales)
 	if err != nil {
 		return nil, err
 	}
@@ -376,7 +378,7 @@ func (c competitorImpl) Abbreviations() (map[protocols.Locale]string, error) {
 }
 
 func (c competitorImpl) LocalizedAbbreviation(locale protocols.Locale) (*string, error) {
-	item, err := c.competitorCache.Competitor(c.id, c.locales)
+	item, err := c.competitorCache.Competitor(c.ctx, c.id, c.locales)
 	if err != nil {
 		return nil, err
 	}
@@ -393,14 +395,14 @@ func (c competitorImpl) LocalizedAbbreviation(locale protocols.Locale) (*string,
 }
 
 func (c competitorImpl) Players() (map[protocols.Locale][]protocols.Player, error) {
-	item, err := c.competitorCache.Competitor(c.id, c.locales)
+	item, err := c.competitorCache.Competitor(c.ctx, c.id, c.locales)
 	if err != nil {
 		return nil, err
 	}
 
 	// If the competitor does not contain any players, try loading them.
 	if len(item.players) == 0 {
-		_, err := c.competitorCache.loadAndCacheItem(c.id, c.locales)
+		_, err := c.competitorCache.loadAndCacheItem(c.ctx, c.id, c.locales)
 		if err != nil {
 			return nil, fmt.Errorf("loading players into cache: %w", err)
 		}
@@ -420,14 +422,14 @@ func (c competitorImpl) Players() (map[protocols.Locale][]protocols.Player, erro
 }
 
 func (c competitorImpl) LocalizedPlayers(locale protocols.Locale) ([]protocols.Player, error) {
-	item, err := c.competitorCache.Competitor(c.id, c.locales)
+	item, err := c.competitorCache.Competitor(c.ctx, c.id, c.locales)
 	if err != nil {
 		return nil, err
 	}
 
 	// If the competitor does not contain any players, try loading them.
 	if len(item.players) == 0 {
-		_, err := c.competitorCache.loadAndCacheItem(c.id, c.locales)
+		_, err := c.competitorCache.loadAndCacheItem(c.ctx, c.id, c.locales)
 		if err != nil {
 			return nil, fmt.Errorf("loading players into cache: %w", err)
 		}
@@ -443,7 +445,7 @@ func (c competitorImpl) LocalizedPlayers(locale protocols.Locale) ([]protocols.P
 }
 
 func (c competitorImpl) Underage() (protocols.UnderageStatus, error) {
-	item, err := c.competitorCache.Competitor(c.id, c.locales)
+	item, err := c.competitorCache.Competitor(c.ctx, c.id, c.locales)
 	if err != nil {
 		return protocols.UnderageUnknown, err
 	}
@@ -451,11 +453,11 @@ func (c competitorImpl) Underage() (protocols.UnderageStatus, error) {
 	underage := item.getUnderage()
 
 	if underage == nil {
-		_, err := c.competitorCache.loadAndCacheItem(c.id, c.locales)
+		_, err := c.competitorCache.loadAndCacheItem(c.ctx, c.id, c.locales)
 		if err != nil {
 			return protocols.UnderageUnknown, fmt.Errorf("loading competitor profile into cache: %w", err)
 		}
-		item, err = c.competitorCache.Competitor(c.id, c.locales)
+		item, err = c.competitorCache.Competitor(c.ctx, c.id, c.locales)
 		if err != nil {
 			return protocols.UnderageUnknown, err
 		}
@@ -519,8 +521,9 @@ func (t teamCompetitorImpl) Underage() (protocols.UnderageStatus, error) {
 }
 
 // NewCompetitor ...
-func NewCompetitor(id protocols.URN, competitorCache *CompetitorCache, entityFactory protocols.EntityFactory, locales []protocols.Locale) protocols.Competitor {
+func NewCompetitor(ctx context.Context, id protocols.URN, competitorCache *CompetitorCache, entityFactory protocols.EntityFactory, locales []protocols.Locale) protocols.Competitor {
 	return &competitorImpl{
+		ctx:             ctx,
 		id:              id,
 		competitorCache: competitorCache,
 		entityFactory:   entityFactory,
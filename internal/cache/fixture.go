@@ -1,6 +1,7 @@
 package cache
 
 import (
+	"context"
 	"time"
 
 	"github.com/oddin-gg/gosdk/internal/api"
@@ -30,14 +31,14 @@ func (f *FixtureCache) OnFeedMessage(id protocols.URN, feedMessage *protocols.Fe
 }
 
 // Fixture ...
-func (f *FixtureCache) Fixture(id protocols.URN, locale protocols.Locale) (*LocalizedFixture, error) {
+func (f *FixtureCache) Fixture(ctx context.Context, id protocols.URN, locale protocols.Locale) (*LocalizedFixture, error) {
 	item, _ := f.internalCache.Get(id.ToString())
 	result, ok := item.(*LocalizedFixture)
 	if ok {
 		return result, nil
 	}
 
-	fixture, err := f.loadAndCacheItem(id, locale)
+	fixture, err := f.loadAndCacheItem(ctx, id, locale)
 	if err != nil {
 		return nil, err
 	}
@@ -50,8 +51,8 @@ func (f *FixtureCache) ClearCacheItem(id protocols.URN) {
 	f.internalCache.Delete(id.ToString())
 }
 
-func (f *FixtureCache) loadAndCacheItem(id protocols.URN, locale protocols.Locale) (*LocalizedFixture, error) {
-	data, err := f.apiClient.FetchFixture(id, locale)
+func (f *FixtureCache) loadAndCacheItem(ctx context.Context, id protocols.URN, locale protocols.Locale) (*LocalizedFixture, error) {
+	data, err := f.apiClient.FetchFixture(ctx, id, locale)
 	if err != nil {
 		return nil, err
 	}
@@ -99,13 +100,14 @@ type LocalizedFixture struct {
 }
 
 type fixtureImpl struct {
+	ctx          context.Context
 	id           protocols.URN
 	fixtureCache *FixtureCache
 	locales      []protocols.Locale
 }
 
 func (f fixtureImpl) StartTime() (*time.Time, error) {
-	item, err := f.fixtureCache.Fixture(f.id, f.locales[0])
+	item, err := f.fixtureCache.Fixture(f.ctx, f.id, f.locales[0])
 	if err != nil {
 		return nil, err
 	}
@@ -114,7 +116,7 @@ func (f fixtureImpl) StartTime() (*time.Time, error) {
 }
 
 func (f fixtureImpl) ExtraInfo() (map[string]string, error) {
-	item, err := f.fixtureCache.Fixture(f.id, f.locales[0])
+	item, err := f.fixtureCache.Fixture(f.ctx, f.id, f.locales[0])
 	if err != nil {
 		return nil, err
 	}
@@ -123,7 +125,7 @@ func (f fixtureImpl) ExtraInfo() (map[string]string, error) {
 }
 
 func (f fixtureImpl) TvChannels() ([]protocols.TvChannel, error) {
-	item, err := f.fixtureCache.Fixture(f.id, f.locales[0])
+	item, err := f.fixtureCache.Fixture(f.ctx, f.id, f.locales[0])
 	if err != nil {
 		return nil, err
 	}
@@ -150,8 +152,9 @@ func (t tvChannelImpl) Language() string {
 }
 
 // NewFixture ...
-func NewFixture(id protocols.URN, fixtureCache *FixtureCache, locales []protocols.Locale) protocols.Fixture {
+func NewFixture(ctx context.Context, id protocols.URN, fixtureCache *FixtureCache, locales []protocols.Locale) protocols.Fixture {
 	return &fixtureImpl{
+		ctx:          ctx,
 		id:           id,
 		fixtureCache: fixtureCache,
 		locales:      locales,
@@ -1,10 +1,10 @@
 package cache
 
 import (
+	"context"
 	"github.com/oddin-gg/gosdk/protocols"
 	log "github.com/sirupsen/logrus"
 	"sync"
-	"time"
 )
 
 type localizedStaticDataImpl struct {
@@ -30,25 +30,20 @@ func (l localizedStaticDataImpl) LocalizedDescription(locale protocols.Locale) *
 	return &description
 }
 
-const (
-	initialDelay = 24 * time.Hour
-	tickPeriod   = 24 * time.Hour
-)
-
 // LocalizedStaticDataCache ...
 type LocalizedStaticDataCache struct {
 	oddsFeedConfiguration protocols.OddsFeedConfiguration
-	fetcher               func(locale protocols.Locale) ([]protocols.StaticData, error)
+	fetcher               func(ctx context.Context, locale protocols.Locale) ([]protocols.StaticData, error)
 	locales               []protocols.Locale
 	internalCache         map[uint]map[protocols.Locale]string
-	ticker                *time.Ticker
+	ticker                protocols.Ticker
 	closeCh               chan bool
 	logger                *log.Logger
 	mux                   sync.Mutex
 }
 
 // LocalizedItem ...
-func (l *LocalizedStaticDataCache) LocalizedItem(id uint, locales []protocols.Locale) (protocols.LocalizedStaticData, error) {
+func (l *LocalizedStaticDataCache) LocalizedItem(ctx context.Context, id uint, locales []protocols.Locale) (protocols.LocalizedStaticData, error) {
 	l.mux.Lock()
 	defer l.mux.Unlock()
 
@@ -64,7 +59,7 @@ func (l *LocalizedStaticDataCache) LocalizedItem(id uint, locales []protocols.Lo
 	}
 
 	if len(missingLocales) != 0 {
-		err := l.fetchData(missingLocales)
+		err := l.fetchData(ctx, missingLocales)
 		if err != nil {
 			return nil, err
 		}
@@ -84,8 +79,8 @@ func (l *LocalizedStaticDataCache) LocalizedItem(id uint, locales []protocols.Lo
 }
 
 // Item ...
-func (l *LocalizedStaticDataCache) Item(id uint) (protocols.LocalizedStaticData, error) {
-	return l.LocalizedItem(id, l.locales)
+func (l *LocalizedStaticDataCache) Item(ctx context.Context, id uint) (protocols.LocalizedStaticData, error) {
+	return l.LocalizedItem(ctx, id, l.locales)
 }
 
 // Close ...
@@ -97,11 +92,11 @@ func (l *LocalizedStaticDataCache) Close() {
 	l.closeCh = nil
 }
 
-func (l *LocalizedStaticDataCache) fetchData(locales []protocols.Locale) error {
+func (l *LocalizedStaticDataCache) fetchData(ctx context.Context, locales []protocols.Locale) error {
 	for i := range locales {
 		locale := locales[i]
 
-		data, err := l.fetcher(locale)
+		data, err := l.fetcher(ctx, locale)
 		if err != nil {
 			return err
 		}
@@ -143,7 +138,7 @@ func (l *LocalizedStaticDataCache) timerTick() {
 		locales[index] = key
 	}
 
-	err := l.fetchData(locales)
+	err := l.fetchData(context.Background(), locales)
 	if err != nil {
 		l.logger.WithError(err).Errorf("failed to periodically fetch static data")
 	}
@@ -153,13 +148,17 @@ func (l *LocalizedStaticDataCache) startTimer() {
 	l.closeCh = make(chan bool, 1)
 
 	go func() {
-		time.Sleep(initialDelay)
+		select {
+		case <-l.oddsFeedConfiguration.Clock().After(l.oddsFeedConfiguration.StaticDataRefreshPeriod()):
+		case <-l.closeCh:
+			return
+		}
 
-		l.ticker = time.NewTicker(tickPeriod)
+		l.ticker = l.oddsFeedConfiguration.Clock().NewTicker(l.oddsFeedConfiguration.StaticDataRefreshPeriod())
 
 		for {
 			select {
-			case <-l.ticker.C:
+			case <-l.ticker.C():
 				l.timerTick()
 
 			case <-l.closeCh:
@@ -169,7 +168,7 @@ func (l *LocalizedStaticDataCache) startTimer() {
 	}()
 }
 
-func newLocalizedStaticDataCache(oddsFeedConfiguration protocols.OddsFeedConfiguration, fetcher func(locale protocols.Locale) ([]protocols.StaticData, error)) *LocalizedStaticDataCache {
+func newLocalizedStaticDataCache(oddsFeedConfiguration protocols.OddsFeedConfiguration, fetcher func(ctx context.Context, locale protocols.Locale) ([]protocols.StaticData, error)) *LocalizedStaticDataCache {
 	ca := &LocalizedStaticDataCache{
 		oddsFeedConfiguration: oddsFeedConfiguration,
 		fetcher:               fetcher,
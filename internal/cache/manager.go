@@ -1,6 +1,7 @@
 package cache
 
 import (
+	"context"
 	"github.com/oddin-gg/gosdk/internal/api"
 	"github.com/oddin-gg/gosdk/protocols"
 	log "github.com/sirupsen/logrus"
@@ -58,8 +59,8 @@ func NewManager(client *api.Client, oddsFeedConfiguration protocols.OddsFeedConf
 		MarketVoidReasonsCache: newMarketVoidReasonsCache(client),
 		PlayersCache:           newPlayersCache(client, logger),
 
-		LocalizedStaticMatchStatus: newLocalizedStaticDataCache(oddsFeedConfiguration, func(locale protocols.Locale) ([]protocols.StaticData, error) {
-			data, err := client.FetchMatchStatusDescriptions(locale)
+		LocalizedStaticMatchStatus: newLocalizedStaticDataCache(oddsFeedConfiguration, func(ctx context.Context, locale protocols.Locale) ([]protocols.StaticData, error) {
+			data, err := client.FetchMatchStatusDescriptions(ctx, locale)
 			if err != nil {
 				return nil, err
 			}
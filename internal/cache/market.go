@@ -1,6 +1,7 @@
 package cache
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
@@ -30,13 +31,13 @@ type MarketDescriptionCache struct {
 }
 
 // LocalizedMarketDescriptions ...
-func (m *MarketDescriptionCache) LocalizedMarketDescriptions(locale protocols.Locale) (map[CompositeKey]*LocalizedMarketDescription, error) {
+func (m *MarketDescriptionCache) LocalizedMarketDescriptions(ctx context.Context, locale protocols.Locale) (map[CompositeKey]*LocalizedMarketDescription, error) {
 	m.mux.Lock()
 	_, ok := m.loadedLocales[locale]
 	m.mux.Unlock()
 
 	if !ok {
-		err := m.loadAndCacheAllItems([]protocols.Locale{locale})
+		err := m.loadAndCacheAllItems(ctx, []protocols.Locale{locale})
 		if err != nil {
 			return nil, err
 		}
@@ -64,6 +65,7 @@ func (m *MarketDescriptionCache) LocalizedMarketDescriptions(locale protocols.Lo
 
 // MarketDescriptionByID returns LocalizedMarketDescription from cache. Error is returned when entity is not found
 func (m *MarketDescriptionCache) MarketDescriptionByID(
+	ctx context.Context,
 	marketID uint,
 	variant *string,
 	locales []protocols.Locale,
@@ -87,7 +89,7 @@ func (m *MarketDescriptionCache) MarketDescriptionByID(
 	}
 
 	if len(missingLocales) != 0 {
-		err := m.loadAndCacheItem(&marketID, variant, missingLocales)
+		err := m.loadAndCacheItem(ctx, &marketID, variant, missingLocales)
 		if err != nil {
 			return nil, err
 		}
@@ -108,7 +110,7 @@ func (m *MarketDescriptionCache) MarketDescriptionByID(
 
 // MarketDescriptionByKey ...
 // Deprecated: do not use this function, there is no load when missing
-func (m *MarketDescriptionCache) MarketDescriptionByKey(key CompositeKey) (*LocalizedMarketDescription, error) {
+func (m *MarketDescriptionCache) MarketDescriptionByKey(ctx context.Context, key CompositeKey) (*LocalizedMarketDescription, error) {
 	strKey := m.makeStringKey(key.MarketID, key.Variant)
 	item, ok := m.internalCache.Get(strKey)
 	if !ok {
@@ -129,11 +131,12 @@ func (m *MarketDescriptionCache) ClearCacheItem(marketID uint, variant *string)
 	m.internalCache.Delete(key)
 }
 
-func (m *MarketDescriptionCache) loadAndCacheAllItems(locales []protocols.Locale) error {
-	return m.loadAndCacheItem(nil, nil, locales)
+func (m *MarketDescriptionCache) loadAndCacheAllItems(ctx context.Context, locales []protocols.Locale) error {
+	return m.loadAndCacheItem(ctx, nil, nil, locales)
 }
 
 func (m *MarketDescriptionCache) loadAndCacheItem(
+	ctx context.Context,
 	marketID *uint,
 	variant *string,
 	locales []protocols.Locale,
@@ -146,13 +149,13 @@ func (m *MarketDescriptionCache) loadAndCacheItem(
 		var descriptions []data.MarketDescription
 		var err error
 		if marketID != nil && variant != nil && utils.IsMarketVariantWithDynamicOutcomes(*variant) {
-			descriptions, err = m.apiClient.FetchMarketDescriptionsWithDynamicOutcomes(*marketID, *variant, locale)
+			descriptions, err = m.apiClient.FetchMarketDescriptionsWithDynamicOutcomes(ctx, *marketID, *variant, locale)
 			if err != nil {
 				return err
 			}
 		} else {
 			// fetch all descriptions
-			descriptions, err = m.apiClient.FetchMarketDescriptions(locale)
+			descriptions, err = m.apiClient.FetchMarketDescriptions(ctx, locale)
 			if err != nil {
 				return err
 			}
@@ -355,6 +358,7 @@ func (o outcomeDescriptionImpl) Description(locale protocols.Locale) *string {
 }
 
 type marketDescriptionImpl struct {
+	ctx                    context.Context
 	id                     uint
 	includesOutcomesOfType *string
 	outcomeType            *string
@@ -368,7 +372,7 @@ func (m marketDescriptionImpl) ID() (uint, error) {
 }
 
 func (m marketDescriptionImpl) RefID() (*uint, error) {
-	item, err := m.marketDescriptionCache.MarketDescriptionByID(m.id, m.variant, m.locales)
+	item, err := m.marketDescriptionCache.MarketDescriptionByID(m.ctx, m.id, m.variant, m.locales)
 	if err != nil {
 		return nil, err
 	}
@@ -377,7 +381,7 @@ func (m marketDescriptionImpl) RefID() (*uint, error) {
 }
 
 func (m marketDescriptionImpl) LocalizedName(locale protocols.Locale) (*string, error) {
-	item, err := m.marketDescriptionCache.MarketDescriptionByID(m.id, m.variant, m.locales)
+	item, err := m.marketDescriptionCache.MarketDescriptionByID(m.ctx, m.id, m.variant, m.locales)
 	if err != nil {
 		return nil, err
 	}
@@ -406,7 +410,7 @@ func (m marketDescriptionImpl) OutcomeType() *string {
 }
 
 func (m marketDescriptionImpl) Outcomes() ([]protocols.OutcomeDescription, error) {
-	item, err := m.marketDescriptionCache.MarketDescriptionByID(m.id, m.variant, m.locales)
+	item, err := m.marketDescriptionCache.MarketDescriptionByID(m.ctx, m.id, m.variant, m.locales)
 	if err != nil {
 		return nil, err
 	}
@@ -431,7 +435,7 @@ func (m marketDescriptionImpl) Variant() (*string, error) {
 }
 
 func (m marketDescriptionImpl) Specifiers() ([]protocols.Specifier, error) {
-	item, err := m.marketDescriptionCache.MarketDescriptionByID(m.id, m.variant, m.locales)
+	item, err := m.marketDescriptionCache.MarketDescriptionByID(m.ctx, m.id, m.variant, m.locales)
 	if err != nil {
 		return nil, err
 	}
@@ -440,7 +444,7 @@ func (m marketDescriptionImpl) Specifiers() ([]protocols.Specifier, error) {
 }
 
 func (m marketDescriptionImpl) Groups() ([]string, error) {
-	item, err := m.marketDescriptionCache.MarketDescriptionByID(m.id, m.variant, m.locales)
+	item, err := m.marketDescriptionCache.MarketDescriptionByID(m.ctx, m.id, m.variant, m.locales)
 	if err != nil {
 		return nil, err
 	}
@@ -450,6 +454,7 @@ func (m marketDescriptionImpl) Groups() ([]string, error) {
 
 // NewMarketDescription ...
 func NewMarketDescription(
+	ctx context.Context,
 	id uint,
 	includesOutcomesOfType *string,
 	outcomeType *string,
@@ -458,6 +463,7 @@ func NewMarketDescription(
 	locales []protocols.Locale,
 ) protocols.MarketDescription {
 	return &marketDescriptionImpl{
+		ctx:                    ctx,
 		id:                     id,
 		includesOutcomesOfType: includesOutcomesOfType,
 		outcomeType:            outcomeType,
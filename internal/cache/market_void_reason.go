@@ -1,6 +1,7 @@
 package cache
 
 import (
+	"context"
 	"errors"
 	"time"
 
@@ -19,10 +20,10 @@ type MarketVoidReasonsCache struct {
 }
 
 // MarketVoidReasons ...
-func (m *MarketVoidReasonsCache) MarketVoidReasons() ([]data.MarketVoidReasons, error) {
+func (m *MarketVoidReasonsCache) MarketVoidReasons(ctx context.Context) ([]data.MarketVoidReasons, error) {
 	d, ok := m.internalCache.Get(MarketVoidReasonCacheKey)
 	if !ok {
-		if err := m.loadAndCacheItem(); err != nil {
+		if err := m.loadAndCacheItem(ctx); err != nil {
 			return nil, err
 		}
 		d, ok = m.internalCache.Get(MarketVoidReasonCacheKey)
@@ -36,12 +37,12 @@ func (m *MarketVoidReasonsCache) MarketVoidReasons() ([]data.MarketVoidReasons,
 }
 
 // ReloadMarketVoidReasons ...
-func (m *MarketVoidReasonsCache) ReloadMarketVoidReasons() error {
-	return m.loadAndCacheItem()
+func (m *MarketVoidReasonsCache) ReloadMarketVoidReasons(ctx context.Context) error {
+	return m.loadAndCacheItem(ctx)
 }
 
-func (m *MarketVoidReasonsCache) loadAndCacheItem() error {
-	voidReasons, err := m.apiClient.FetchMarketVoidReasons()
+func (m *MarketVoidReasonsCache) loadAndCacheItem(ctx context.Context) error {
+	voidReasons, err := m.apiClient.FetchMarketVoidReasons(ctx)
 	if err != nil {
 		return err
 	}
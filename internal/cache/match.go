@@ -1,6 +1,7 @@
 package cache
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sync"
@@ -68,7 +69,7 @@ func (m *MatchCache) ClearCacheItem(id protocols.URN) {
 }
 
 // Match ...
-func (m *MatchCache) Match(id protocols.URN, locales []protocols.Locale) (*LocalizedMatch, error) {
+func (m *MatchCache) Match(ctx context.Context, id protocols.URN, locales []protocols.Locale) (*LocalizedMatch, error) {
 	item, _ := m.internalCache.Get(id.ToString())
 	result, ok := item.(*LocalizedMatch)
 
@@ -89,7 +90,7 @@ func (m *MatchCache) Match(id protocols.URN, locales []protocols.Locale) (*Local
 	}
 
 	if len(missingLocales) != 0 {
-		err := m.loadAndCacheItem(id, locales)
+		err := m.loadAndCacheItem(ctx, id, locales)
 		if err != nil {
 			return nil, err
 		}
@@ -104,10 +105,37 @@ func (m *MatchCache) Match(id protocols.URN, locales []protocols.Locale) (*Local
 	return result, nil
 }
 
-func (m *MatchCache) loadAndCacheItem(id protocols.URN, locales []protocols.Locale) error {
+// TournamentID ...
+func (m *MatchCache) TournamentID(ctx context.Context, id protocols.URN, locale protocols.Locale) (*protocols.URN, error) {
+	item, err := m.Match(ctx, id, []protocols.Locale{locale})
+	if err != nil {
+		return nil, err
+	}
+
+	item.mux.Lock()
+	defer item.mux.Unlock()
+
+	tournamentID := item.tournamentID
+	return &tournamentID, nil
+}
+
+// SportFormat ...
+func (m *MatchCache) SportFormat(ctx context.Context, id protocols.URN, locale protocols.Locale) (protocols.SportFormat, error) {
+	item, err := m.Match(ctx, id, []protocols.Locale{locale})
+	if err != nil {
+		return protocols.SportFormatUnknown, err
+	}
+
+	item.mux.Lock()
+	defer item.mux.Unlock()
+
+	return item.sportFormat, nil
+}
+
+func (m *MatchCache) loadAndCacheItem(ctx context.Context, id protocols.URN, locales []protocols.Locale) error {
 	for i := range locales {
 		locale := locales[i]
-		data, err := m.apiClient.FetchMatchSummary(id, locale)
+		data, err := m.apiClient.FetchMatchSummary(ctx, id, locale)
 		if err != nil {
 			return err
 		}
@@ -295,6 +323,7 @@ type competitor struct {
 }
 
 type matchImpl struct {
+	ctx           context.Context
 	id            protocols.URN
 	localSportID  *protocols.URN
 	matchCache    *MatchCache
@@ -308,7 +337,7 @@ func (m matchImpl) ID() protocols.URN {
 
 // Deprecated: do not use this method, it will be removed in future
 func (m matchImpl) RefID() (*protocols.URN, error) {
-	item, err := m.matchCache.Match(m.id, m.locales)
+	item, err := m.matchCache.Match(m.ctx, m.id, m.locales)
 	if err != nil {
 		return nil, err
 	}
@@ -317,7 +346,7 @@ func (m matchImpl) RefID() (*protocols.URN, error) {
 }
 
 func (m matchImpl) LocalizedName(locale protocols.Locale) (*string, error) {
-	item, err := m.matchCache.Match(m.id, m.locales)
+	item, err := m.matchCache.Match(m.ctx, m.id, m.locales)
 	if err != nil {
 		return nil, err
 	}
@@ -338,7 +367,7 @@ func (m matchImpl) SportID() (*protocols.URN, error) {
 		return m.localSportID, nil
 	}
 
-	item, err := m.matchCache.Match(m.id, m.locales)
+	item, err := m.matchCache.Match(m.ctx, m.id, m.locales)
 	if err != nil {
 		return nil, err
 	}
@@ -348,7 +377,7 @@ func (m matchImpl) SportID() (*protocols.URN, error) {
 }
 
 func (m matchImpl) ScheduledTime() (*time.Time, error) {
-	item, err := m.matchCache.Match(m.id, m.locales)
+	item, err := m.matchCache.Match(m.ctx, m.id, m.locales)
 	if err != nil {
 		return nil, err
 	}
@@ -357,7 +386,7 @@ func (m matchImpl) ScheduledTime() (*time.Time, error) {
 }
 
 func (m matchImpl) ScheduledEndTime() (*time.Time, error) {
-	item, err := m.matchCache.Match(m.id, m.locales)
+	item, err := m.matchCache.Match(m.ctx, m.id, m.locales)
 	if err != nil {
 		return nil, err
 	}
@@ -366,7 +395,7 @@ func (m matchImpl) ScheduledEndTime() (*time.Time, error) {
 }
 
 func (m matchImpl) ReferenceIDs() (map[string]string, error) {
-	item, err := m.matchCache.Match(m.id, m.locales)
+	item, err := m.matchCache.Match(m.ctx, m.id, m.locales)
 	if err != nil {
 		return nil, err
 	}
@@ -375,7 +404,7 @@ func (m matchImpl) ReferenceIDs() (map[string]string, error) {
 }
 
 func (m matchImpl) LiveOddsAvailability() (*protocols.LiveOddsAvailability, error) {
-	item, err := m.matchCache.Match(m.id, m.locales)
+	item, err := m.matchCache.Match(m.ctx, m.id, m.locales)
 	if err != nil {
 		return nil, err
 	}
@@ -384,7 +413,7 @@ func (m matchImpl) LiveOddsAvailability() (*protocols.LiveOddsAvailability, erro
 }
 
 func (m matchImpl) Competitors() ([]protocols.Competitor, error) {
-	item, err := m.matchCache.Match(m.id, m.locales)
+	item, err := m.matchCache.Match(m.ctx, m.id, m.locales)
 	if err != nil {
 		return nil, err
 	}
@@ -405,7 +434,7 @@ func (m matchImpl) Competitors() ([]protocols.Competitor, error) {
 }
 
 func (m matchImpl) SportFormat() (protocols.SportFormat, error) {
-	item, err := m.matchCache.Match(m.id, m.locales)
+	item, err := m.matchCache.Match(m.ctx, m.id, m.locales)
 	if err != nil {
 		return protocols.SportFormatUnknown, err
 	}
@@ -414,7 +443,7 @@ func (m matchImpl) SportFormat() (protocols.SportFormat, error) {
 }
 
 func (m matchImpl) ExtraInfo() (map[string]string, error) {
-	item, err := m.matchCache.Match(m.id, m.locales)
+	item, err := m.matchCache.Match(m.ctx, m.id, m.locales)
 	if err != nil {
 		return nil, err
 	}
@@ -432,7 +461,7 @@ func (m matchImpl) Tournament() (protocols.Tournament, error) {
 		return nil, err
 	}
 
-	item, err := m.matchCache.Match(m.id, m.locales)
+	item, err := m.matchCache.Match(m.ctx, m.id, m.locales)
 	if err != nil {
 		return nil, err
 	}
@@ -441,7 +470,7 @@ func (m matchImpl) Tournament() (protocols.Tournament, error) {
 }
 
 func (m matchImpl) homeAwayCompetitor(home bool) (protocols.TeamCompetitor, error) {
-	item, err := m.matchCache.Match(m.id, m.locales)
+	item, err := m.matchCache.Match(m.ctx, m.id, m.locales)
 	if err != nil {
 		return nil, err
 	}
@@ -482,8 +511,9 @@ func (m matchImpl) Fixture() protocols.Fixture {
 }
 
 // NewMatch ...
-func NewMatch(id protocols.URN, sportID *protocols.URN, matchCache *MatchCache, entityFactory protocols.EntityFactory, locales []protocols.Locale) protocols.Match {
+func NewMatch(ctx context.Context, id protocols.URN, sportID *protocols.URN, matchCache *MatchCache, entityFactory protocols.EntityFactory, locales []protocols.Locale) protocols.Match {
 	return &matchImpl{
+		ctx:           ctx,
 		id:            id,
 		localSportID:  sportID,
 		matchCache:    matchCache,
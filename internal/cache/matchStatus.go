@@ -1,6 +1,7 @@
 package cache
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
@@ -357,7 +358,7 @@ func (m MatchStatusCache) ClearCacheItem(id protocols.URN) {
 }
 
 // MatchStatus ...
-func (m MatchStatusCache) MatchStatus(id protocols.URN) (*LocalizedMatchStatus, error) {
+func (m MatchStatusCache) MatchStatus(ctx context.Context, id protocols.URN) (*LocalizedMatchStatus, error) {
 	item, _ := m.internalCache.Get(id.ToString())
 	result, ok := item.(*LocalizedMatchStatus)
 	if ok {
@@ -365,7 +366,7 @@ func (m MatchStatusCache) MatchStatus(id protocols.URN) (*LocalizedMatchStatus,
 	}
 
 	// This will trigger OnAPIResponse callback
-	_, err := m.apiClient.FetchMatchSummary(id, m.oddsFeedConfiguration.DefaultLocale())
+	_, err := m.apiClient.FetchMatchSummary(ctx, id, m.oddsFeedConfiguration.DefaultLocale())
 	if err != nil {
 		return nil, err
 	}
@@ -713,6 +714,7 @@ func (s statisticsImpl) AwayCorners() *uint32 {
 }
 
 type matchStatusImpl struct {
+	ctx                             context.Context
 	sportEventID                    protocols.URN
 	matchStatusCache                *MatchStatusCache
 	localizedStaticMatchStatusCache *LocalizedStaticDataCache
@@ -720,7 +722,7 @@ type matchStatusImpl struct {
 }
 
 func (m matchStatusImpl) WinnerID() (*protocols.URN, error) {
-	item, err := m.matchStatusCache.MatchStatus(m.sportEventID)
+	item, err := m.matchStatusCache.MatchStatus(m.ctx, m.sportEventID)
 	if err != nil {
 		return nil, err
 	}
@@ -729,7 +731,7 @@ func (m matchStatusImpl) WinnerID() (*protocols.URN, error) {
 }
 
 func (m matchStatusImpl) Status() (*protocols.EventStatus, error) {
-	item, err := m.matchStatusCache.MatchStatus(m.sportEventID)
+	item, err := m.matchStatusCache.MatchStatus(m.ctx, m.sportEventID)
 	if err != nil {
 		return nil, err
 	}
@@ -738,7 +740,7 @@ func (m matchStatusImpl) Status() (*protocols.EventStatus, error) {
 }
 
 func (m matchStatusImpl) PeriodScores() ([]protocols.PeriodScore, error) {
-	item, err := m.matchStatusCache.MatchStatus(m.sportEventID)
+	item, err := m.matchStatusCache.MatchStatus(m.ctx, m.sportEventID)
 	if err != nil {
 		return nil, err
 	}
@@ -747,7 +749,7 @@ func (m matchStatusImpl) PeriodScores() ([]protocols.PeriodScore, error) {
 }
 
 func (m matchStatusImpl) MatchStatusID() (*uint, error) {
-	item, err := m.matchStatusCache.MatchStatus(m.sportEventID)
+	item, err := m.matchStatusCache.MatchStatus(m.ctx, m.sportEventID)
 	if err != nil {
 		return nil, err
 	}
@@ -761,7 +763,7 @@ func (m matchStatusImpl) MatchStatus() (protocols.LocalizedStaticData, error) {
 		return nil, err
 	}
 
-	return m.localizedStaticMatchStatusCache.LocalizedItem(*status, m.locales)
+	return m.localizedStaticMatchStatusCache.LocalizedItem(m.ctx, *status, m.locales)
 }
 
 func (m matchStatusImpl) LocalizedMatchStatus(locale protocols.Locale) (protocols.LocalizedStaticData, error) {
@@ -770,11 +772,11 @@ func (m matchStatusImpl) LocalizedMatchStatus(locale protocols.Locale) (protocol
 		return nil, err
 	}
 
-	return m.localizedStaticMatchStatusCache.LocalizedItem(*status, []protocols.Locale{locale})
+	return m.localizedStaticMatchStatusCache.LocalizedItem(m.ctx, *status, []protocols.Locale{locale})
 }
 
 func (m matchStatusImpl) HomeScore() (*float64, error) {
-	item, err := m.matchStatusCache.MatchStatus(m.sportEventID)
+	item, err := m.matchStatusCache.MatchStatus(m.ctx, m.sportEventID)
 	if err != nil {
 		return nil, err
 	}
@@ -783,7 +785,7 @@ func (m matchStatusImpl) HomeScore() (*float64, error) {
 }
 
 func (m matchStatusImpl) AwayScore() (*float64, error) {
-	item, err := m.matchStatusCache.MatchStatus(m.sportEventID)
+	item, err := m.matchStatusCache.MatchStatus(m.ctx, m.sportEventID)
 	if err != nil {
 		return nil, err
 	}
@@ -792,7 +794,7 @@ func (m matchStatusImpl) AwayScore() (*float64, error) {
 }
 
 func (m matchStatusImpl) IsScoreboardAvailable() (bool, error) {
-	item, err := m.matchStatusCache.MatchStatus(m.sportEventID)
+	item, err := m.matchStatusCache.MatchStatus(m.ctx, m.sportEventID)
 	if err != nil {
 		return false, err
 	}
@@ -801,7 +803,7 @@ func (m matchStatusImpl) IsScoreboardAvailable() (bool, error) {
 }
 
 func (m matchStatusImpl) Statistics() (protocols.Statistics, error) {
-	item, err := m.matchStatusCache.MatchStatus(m.sportEventID)
+	item, err := m.matchStatusCache.MatchStatus(m.ctx, m.sportEventID)
 	if err != nil {
 		return nil, fmt.Errorf("matchStatusImpl.Statistics unable to get match status: %w", err)
 	}
@@ -809,7 +811,7 @@ func (m matchStatusImpl) Statistics() (protocols.Statistics, error) {
 }
 
 func (m matchStatusImpl) Scoreboard() (protocols.Scoreboard, error) {
-	item, err := m.matchStatusCache.MatchStatus(m.sportEventID)
+	item, err := m.matchStatusCache.MatchStatus(m.ctx, m.sportEventID)
 	if err != nil {
 		return nil, err
 	}
@@ -818,8 +820,9 @@ func (m matchStatusImpl) Scoreboard() (protocols.Scoreboard, error) {
 }
 
 // NewMatchStatus ...
-func NewMatchStatus(sportEventID protocols.URN, matchStatusCache *MatchStatusCache, localizedStaticMatchStatusCache *LocalizedStaticDataCache, locales []protocols.Locale) protocols.MatchStatus {
+func NewMatchStatus(ctx context.Context, sportEventID protocols.URN, matchStatusCache *MatchStatusCache, localizedStaticMatchStatusCache *LocalizedStaticDataCache, locales []protocols.Locale) protocols.MatchStatus {
 	return &matchStatusImpl{
+		ctx:                             ctx,
 		sportEventID:                    sportEventID,
 		matchStatusCache:                matchStatusCache,
 		localizedStaticMatchStatusCache: localizedStaticMatchStatusCache,
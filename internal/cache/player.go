@@ -1,6 +1,7 @@
 package cache
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"time"
@@ -48,8 +49,8 @@ func (c *PlayersCache) OnAPIResponse(apiResponse protocols.Response) {
 
 // GetPlayer returns cached LocalizedPlayer if is in cache, if it is not then the team is fetched via api and stored in cache.
 // If Player does not exist then ErrItemNotFoundInCache error is returned
-func (c *PlayersCache) GetPlayer(id PlayerCacheKey) (*LocalizedPlayer, error) {
-	players, err := c.GetPlayers([]PlayerCacheKey{id})
+func (c *PlayersCache) GetPlayer(ctx context.Context, id PlayerCacheKey) (*LocalizedPlayer, error) {
+	players, err := c.GetPlayers(ctx, []PlayerCacheKey{id})
 	switch {
 	case err != nil:
 		return nil, fmt.Errorf("get player from cache failed: %w", err)
@@ -68,7 +69,7 @@ func (c *PlayersCache) GetPlayer(id PlayerCacheKey) (*LocalizedPlayer, error) {
 
 // GetPlayers returns map of cached LocalizedPlayer if they are in cache, if any Player is missing then it is fetched via
 // api and stored in cache.
-func (c *PlayersCache) GetPlayers(ids []PlayerCacheKey) (map[PlayerCacheKey]LocalizedPlayer, error) {
+func (c *PlayersCache) GetPlayers(ctx context.Context, ids []PlayerCacheKey) (map[PlayerCacheKey]LocalizedPlayer, error) {
 	resultPlayers, missingPlayersIDs := c.getPlayersFromCache(ids)
 	if len(missingPlayersIDs) == 0 {
 		return resultPlayers, nil
@@ -83,7 +84,7 @@ func (c *PlayersCache) GetPlayers(ids []PlayerCacheKey) (map[PlayerCacheKey]Loca
 		return resultPlayers, nil
 	}
 
-	dbPlayers, err := c.fetchPlayersFromAPI(missingPlayersIDs)
+	dbPlayers, err := c.fetchPlayersFromAPI(ctx, missingPlayersIDs)
 	if err != nil {
 		return nil, fmt.Errorf("GetPlayers failed: %w", err)
 	}
@@ -153,11 +154,11 @@ func (c *PlayersCache) getPlayersFromCache(
 	return foundPlayers, missingPlayersIDs
 }
 
-func (c *PlayersCache) fetchPlayersFromAPI(keys []PlayerCacheKey) (map[PlayerCacheKey]xml.PlayerProfile, error) {
+func (c *PlayersCache) fetchPlayersFromAPI(ctx context.Context, keys []PlayerCacheKey) (map[PlayerCacheKey]xml.PlayerProfile, error) {
 	res := make(map[PlayerCacheKey]xml.PlayerProfile, len(keys))
 
 	for _, key := range keys {
-		data, err := c.apiClient.FetchPlayerProfile(key.PlayerID, key.Locale)
+		data, err := c.apiClient.FetchPlayerProfile(ctx, key.PlayerID, key.Locale)
 
 		if err != nil {
 			return nil, fmt.Errorf("fetch player profiles failed: %w", err)
@@ -208,6 +209,7 @@ type LocalizedPlayer struct {
 }
 
 type playerImpl struct {
+	ctx         context.Context
 	key         PlayerCacheKey
 	playerCache *PlayersCache
 }
@@ -217,7 +219,7 @@ func (p playerImpl) ID() string {
 }
 
 func (p playerImpl) LocalizedName() (string, error) {
-	item, err := p.playerCache.GetPlayer(p.key)
+	item, err := p.playerCache.GetPlayer(p.ctx, p.key)
 	if err != nil {
 		return "", fmt.Errorf("getting player from cache: %w", err)
 	}
@@ -226,7 +228,7 @@ func (p playerImpl) LocalizedName() (string, error) {
 }
 
 func (p playerImpl) FullName() (string, error) {
-	item, err := p.playerCache.GetPlayer(p.key)
+	item, err := p.playerCache.GetPlayer(p.ctx, p.key)
 	if err != nil {
 		return "", fmt.Errorf("getting player from cache: %w", err)
 	}
@@ -235,7 +237,7 @@ func (p playerImpl) FullName() (string, error) {
 }
 
 func (p playerImpl) SportID() (string, error) {
-	item, err := p.playerCache.GetPlayer(p.key)
+	item, err := p.playerCache.GetPlayer(p.ctx, p.key)
 	if err != nil {
 		return "", fmt.Errorf("getting player from cache: %w", err)
 	}
@@ -244,10 +246,11 @@ func (p playerImpl) SportID() (string, error) {
 }
 
 // NewPlayer ...
-func NewPlayer(id protocols.URN, playerCache *PlayersCache, locale protocols.Locale) protocols.Player {
+func NewPlayer(ctx context.Context, id protocols.URN, playerCache *PlayersCache, locale protocols.Locale) protocols.Player {
 	key := PlayerCacheKey{PlayerID: id.ToString(), Locale: locale}
 
 	return &playerImpl{
+		ctx:         ctx,
 		key:         key,
 		playerCache: playerCache,
 	}
@@ -1,6 +1,7 @@
 package cache
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sync"
@@ -42,7 +43,7 @@ func (s *SportCache) OnAPIResponse(apiResponse protocols.Response) {
 }
 
 // Sport ...
-func (s *SportCache) Sport(id protocols.URN, locales []protocols.Locale) (*LocalizedSport, error) {
+func (s *SportCache) Sport(ctx context.Context, id protocols.URN, locales []protocols.Locale) (*LocalizedSport, error) {
 	item, _ := s.internalCache.Get(id.ToString())
 	result, ok := item.(*LocalizedSport)
 
@@ -52,7 +53,7 @@ func (s *SportCache) Sport(id protocols.URN, locales []protocols.Locale) (*Local
 	} else {
 		missingLocales = s.findMissingLocales(locales)
 		if len(missingLocales) != 0 {
-			err := s.loadAndCacheItems(missingLocales)
+			err := s.loadAndCacheItems(ctx, missingLocales)
 			if err != nil {
 				return nil, err
 			}
@@ -60,7 +61,7 @@ func (s *SportCache) Sport(id protocols.URN, locales []protocols.Locale) (*Local
 	}
 
 	if len(missingLocales) != 0 {
-		err := s.loadAndCacheItems(missingLocales)
+		err := s.loadAndCacheItems(ctx, missingLocales)
 		if err != nil {
 			return nil, err
 		}
@@ -75,10 +76,10 @@ func (s *SportCache) Sport(id protocols.URN, locales []protocols.Locale) (*Local
 }
 
 // Sports ...
-func (s *SportCache) Sports(locales []protocols.Locale) ([]protocols.URN, error) {
+func (s *SportCache) Sports(ctx context.Context, locales []protocols.Locale) ([]protocols.URN, error) {
 	missingLocales := s.findMissingLocales(locales)
 	if len(missingLocales) != 0 {
-		err := s.loadAndCacheItems(missingLocales)
+		err := s.loadAndCacheItems(ctx, missingLocales)
 		if err != nil {
 			return nil, err
 		}
@@ -101,14 +102,14 @@ func (s *SportCache) Sports(locales []protocols.Locale) ([]protocols.URN, error)
 }
 
 // SportTournaments ...
-func (s *SportCache) SportTournaments(sportID protocols.URN, locale protocols.Locale) ([]protocols.URN, error) {
+func (s *SportCache) SportTournaments(ctx context.Context, sportID protocols.URN, locale protocols.Locale) ([]protocols.URN, error) {
 	item, _ := s.internalCache.Get(sportID.ToString())
 	result, ok := item.(*LocalizedSport)
 	if ok && len(result.tournamentIDs) != 0 {
 		return result.makeTournamentIDsList(), nil
 	}
 
-	tournaments, err := s.apiClient.FetchTournaments(sportID, locale)
+	tournaments, err := s.apiClient.FetchTournaments(ctx, sportID, locale)
 	if err != nil {
 		return nil, err
 	}
@@ -145,10 +146,10 @@ func (s *SportCache) findMissingLocales(locales []protocols.Locale) []protocols.
 	return missingLocales
 }
 
-func (s *SportCache) loadAndCacheItems(locales []protocols.Locale) error {
+func (s *SportCache) loadAndCacheItems(ctx context.Context, locales []protocols.Locale) error {
 	for i := range locales {
 		locale := locales[i]
-		data, err := s.apiClient.FetchSports(locale)
+		data, err := s.apiClient.FetchSports(ctx, locale)
 		if err != nil {
 			return err
 		}
@@ -271,6 +272,7 @@ func (l *LocalizedSport) makeTournamentIDsList() []protocols.URN {
 }
 
 type sportImpl struct {
+	ctx            context.Context
 	id             protocols.URN
 	sportDataCache *SportCache
 	entityFactory  protocols.EntityFactory
@@ -278,7 +280,7 @@ type sportImpl struct {
 }
 
 func (s sportImpl) IconPath() (*string, error) {
-	item, err := s.sportDataCache.Sport(s.id, s.locales)
+	item, err := s.sportDataCache.Sport(s.ctx, s.id, s.locales)
 	if err != nil {
 		return nil, err
 	}
@@ -292,7 +294,7 @@ func (s sportImpl) ID() protocols.URN {
 
 // Deprecated: do not use this method, it will be removed in future
 func (s sportImpl) RefID() (*protocols.URN, error) {
-	item, err := s.sportDataCache.Sport(s.id, s.locales)
+	item, err := s.sportDataCache.Sport(s.ctx, s.id, s.locales)
 	if err != nil {
 		return nil, err
 	}
@@ -301,7 +303,7 @@ func (s sportImpl) RefID() (*protocols.URN, error) {
 }
 
 func (s sportImpl) Names() (map[protocols.Locale]string, error) {
-	item, err := s.sportDataCache.Sport(s.id, s.locales)
+	item, err := s.sportDataCache.Sport(s.ctx, s.id, s.locales)
 	if err != nil {
 		return nil, err
 	}
@@ -319,7 +321,7 @@ func (s sportImpl) Names() (map[protocols.Locale]string, error) {
 }
 
 func (s sportImpl) LocalizedName(locale protocols.Locale) (*string, error) {
-	item, err := s.sportDataCache.Sport(s.id, s.locales)
+	item, err := s.sportDataCache.Sport(s.ctx, s.id, s.locales)
 	if err != nil {
 		return nil, err
 	}
@@ -336,7 +338,7 @@ func (s sportImpl) LocalizedName(locale protocols.Locale) (*string, error) {
 }
 
 func (s sportImpl) LocalizedAbbreviation(locale protocols.Locale) (*string, error) {
-	item, err := s.sportDataCache.Sport(s.id, s.locales)
+	item, err := s.sportDataCache.Sport(s.ctx, s.id, s.locales)
 	if err != nil {
 		return nil, err
 	}
@@ -353,14 +355,14 @@ func (s sportImpl) LocalizedAbbreviation(locale protocols.Locale) (*string, erro
 }
 
 func (s sportImpl) Tournaments() ([]protocols.Tournament, error) {
-	item, err := s.sportDataCache.Sport(s.id, s.locales)
+	item, err := s.sportDataCache.Sport(s.ctx, s.id, s.locales)
 	if err != nil {
 		return nil, err
 	}
 
 	tournamentIDs := item.makeTournamentIDsList()
 	if len(tournamentIDs) == 0 {
-		tournamentIDs, err = s.sportDataCache.SportTournaments(s.id, s.locales[0])
+		tournamentIDs, err = s.sportDataCache.SportTournaments(s.ctx, s.id, s.locales[0])
 		if err != nil {
 			return nil, err
 		}
@@ -370,8 +372,9 @@ func (s sportImpl) Tournaments() ([]protocols.Tournament, error) {
 }
 
 // NewSport ...
-func NewSport(id protocols.URN, dataCache *SportCache, entityFactory protocols.EntityFactory, locales []protocols.Locale) protocols.Sport {
+func NewSport(ctx context.Context, id protocols.URN, dataCache *SportCache, entityFactory protocols.EntityFactory, locales []protocols.Locale) protocols.Sport {
 	return &sportImpl{
+		ctx:            ctx,
 		id:             id,
 		sportDataCache: dataCache,
 		entityFactory:  entityFactory,
@@ -1,6 +1,7 @@
 package cache
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sync"
@@ -117,7 +118,7 @@ func (t *TournamentCache) ClearCacheItem(id protocols.URN) {
 }
 
 // Tournament ...
-func (t *TournamentCache) Tournament(id protocols.URN, locales []protocols.Locale) (*LocalizedTournament, error) {
+func (t *TournamentCache) Tournament(ctx context.Context, id protocols.URN, locales []protocols.Locale) (*LocalizedTournament, error) {
 	item, _ := t.internalCache.Get(id.ToString())
 	result, ok := item.(*LocalizedTournament)
 
@@ -138,7 +139,7 @@ func (t *TournamentCache) Tournament(id protocols.URN, locales []protocols.Local
 	}
 
 	if len(missingLocales) != 0 {
-		err := t.loadAndCacheItem(id, locales)
+		err := t.loadAndCacheItem(ctx, id, locales)
 		if err != nil {
 			return nil, err
 		}
@@ -154,7 +155,7 @@ func (t *TournamentCache) Tournament(id protocols.URN, locales []protocols.Local
 }
 
 // TournamentCompetitors ...
-func (t *TournamentCache) TournamentCompetitors(id protocols.URN, locale protocols.Locale) ([]protocols.URN, error) {
+func (t *TournamentCache) TournamentCompetitors(ctx context.Context, id protocols.URN, locale protocols.Locale) ([]protocols.URN, error) {
 	item, _ := t.internalCache.Get(id.ToString())
 	result, ok := item.(*LocalizedTournament)
 
@@ -162,7 +163,7 @@ func (t *TournamentCache) TournamentCompetitors(id protocols.URN, locale protoco
 	if ok && len(result.competitorIDs) != 0 {
 		competitorIDs = result.competitorIDs
 	} else {
-		err := t.loadAndCacheItem(id, []protocols.Locale{locale})
+		err := t.loadAndCacheItem(ctx, id, []protocols.Locale{locale})
 		if err != nil {
 			return nil, err
 		}
@@ -183,13 +184,13 @@ func (t *TournamentCache) TournamentCompetitors(id protocols.URN, locale protoco
 }
 
 // TournamentIcon ...
-func (t *TournamentCache) TournamentIcon(id protocols.URN, locale protocols.Locale) (*string, error) {
+func (t *TournamentCache) TournamentIcon(ctx context.Context, id protocols.URN, locale protocols.Locale) (*string, error) {
 	icon, ok := t.iconCache.Get(id.ToString())
 	if ok {
 		return icon.(*string), nil
 	}
 
-	data, err := t.apiClient.FetchTournament(id, locale)
+	data, err := t.apiClient.FetchTournament(ctx, id, locale)
 	if err != nil {
 		return nil, err
 	}
@@ -198,10 +199,23 @@ func (t *TournamentCache) TournamentIcon(id protocols.URN, locale protocols.Loca
 	return data.IconPath, nil
 }
 
-func (t *TournamentCache) loadAndCacheItem(id protocols.URN, locales []protocols.Locale) error {
+// RiskTier ...
+func (t *TournamentCache) RiskTier(ctx context.Context, id protocols.URN, locale protocols.Locale) (int, error) {
+	item, err := t.Tournament(ctx, id, []protocols.Locale{locale})
+	if err != nil {
+		return 0, err
+	}
+
+	item.mux.Lock()
+	defer item.mux.Unlock()
+
+	return item.riskTier, nil
+}
+
+func (t *TournamentCache) loadAndCacheItem(ctx context.Context, id protocols.URN, locales []protocols.Locale) error {
 	for i := range locales {
 		locale := locales[i]
-		data, err := t.apiClient.FetchTournament(id, locale)
+		data, err := t.apiClient.FetchTournament(ctx, id, locale)
 		if err != nil {
 			return err
 		}
@@ -321,6 +335,7 @@ type LocalizedTournament struct {
 }
 
 type tournamentImpl struct {
+	ctx             context.Context
 	id              protocols.URN
 	sportID         protocols.URN
 	tournamentCache *TournamentCache
@@ -333,7 +348,7 @@ func (t tournamentImpl) IconPath() (*string, error) {
 		return nil, errors.New("missing locales")
 	}
 
-	item, err := t.tournamentCache.TournamentIcon(t.id, t.locales[0])
+	item, err := t.tournamentCache.TournamentIcon(t.ctx, t.id, t.locales[0])
 	if err != nil {
 		return nil, err
 	}
@@ -346,7 +361,7 @@ func (t tournamentImpl) ID() protocols.URN {
 }
 
 func (t tournamentImpl) RefID() (*protocols.URN, error) {
-	item, err := t.tournamentCache.Tournament(t.id, t.locales)
+	item, err := t.tournamentCache.Tournament(t.ctx, t.id, t.locales)
 	if err != nil {
 		return nil, err
 	}
@@ -355,7 +370,7 @@ func (t tournamentImpl) RefID() (*protocols.URN, error) {
 }
 
 func (t tournamentImpl) LocalizedAbbreviation(locale protocols.Locale) (*string, error) {
-	item, err := t.tournamentCache.Tournament(t.id, []protocols.Locale{locale})
+	item, err := t.tournamentCache.Tournament(t.ctx, t.id, []protocols.Locale{locale})
 	if err != nil {
 		return nil, err
 	}
@@ -372,7 +387,7 @@ func (t tournamentImpl) LocalizedAbbreviation(locale protocols.Locale) (*string,
 }
 
 func (t tournamentImpl) LocalizedName(locale protocols.Locale) (*string, error) {
-	item, err := t.tournamentCache.Tournament(t.id, []protocols.Locale{locale})
+	item, err := t.tournamentCache.Tournament(t.ctx, t.id, []protocols.Locale{locale})
 	if err != nil {
 		return nil, err
 	}
@@ -393,7 +408,7 @@ func (t tournamentImpl) SportID() (*protocols.URN, error) {
 }
 
 func (t tournamentImpl) ScheduledTime() (*time.Time, error) {
-	item, err := t.tournamentCache.Tournament(t.id, t.locales)
+	item, err := t.tournamentCache.Tournament(t.ctx, t.id, t.locales)
 	if err != nil {
 		return nil, err
 	}
@@ -402,7 +417,7 @@ func (t tournamentImpl) ScheduledTime() (*time.Time, error) {
 }
 
 func (t tournamentImpl) ScheduledEndTime() (*time.Time, error) {
-	item, err := t.tournamentCache.Tournament(t.id, t.locales)
+	item, err := t.tournamentCache.Tournament(t.ctx, t.id, t.locales)
 	if err != nil {
 		return nil, err
 	}
@@ -420,14 +435,14 @@ func (t tournamentImpl) Sport() protocols.SportSummary {
 }
 
 func (t tournamentImpl) Competitors() ([]protocols.Competitor, error) {
-	item, err := t.tournamentCache.Tournament(t.id, t.locales)
+	item, err := t.tournamentCache.Tournament(t.ctx, t.id, t.locales)
 	if err != nil {
 		return nil, err
 	}
 
 	var competitors []protocols.URN
 	if len(item.competitorIDs) == 0 {
-		competitors, err = t.tournamentCache.TournamentCompetitors(t.id, t.locales[0])
+		competitors, err = t.tournamentCache.TournamentCompetitors(t.ctx, t.id, t.locales[0])
 		if err != nil {
 			return nil, err
 		}
@@ -441,7 +456,7 @@ func (t tournamentImpl) Competitors() ([]protocols.Competitor, error) {
 }
 
 func (t tournamentImpl) StartDate() (*time.Time, error) {
-	item, err := t.tournamentCache.Tournament(t.id, t.locales)
+	item, err := t.tournamentCache.Tournament(t.ctx, t.id, t.locales)
 	if err != nil {
 		return nil, err
 	}
@@ -450,7 +465,7 @@ func (t tournamentImpl) StartDate() (*time.Time, error) {
 }
 
 func (t tournamentImpl) EndDate() (*time.Time, error) {
-	item, err := t.tournamentCache.Tournament(t.id, t.locales)
+	item, err := t.tournamentCache.Tournament(t.ctx, t.id, t.locales)
 	if err != nil {
 		return nil, err
 	}
@@ -459,7 +474,7 @@ func (t tournamentImpl) EndDate() (*time.Time, error) {
 }
 
 func (t tournamentImpl) RiskTier() (int, error) {
-	item, err := t.tournamentCache.Tournament(t.id, t.locales)
+	item, err := t.tournamentCache.Tournament(t.ctx, t.id, t.locales)
 	if err != nil {
 		return 0, err
 	}
@@ -486,7 +501,7 @@ func (c *categoryImpl) CountryCode() *string {
 }
 
 func (t tournamentImpl) Category() (protocols.Category, error) {
-	item, err := t.tournamentCache.Tournament(t.id, t.locales)
+	item, err := t.tournamentCache.Tournament(t.ctx, t.id, t.locales)
 	if err != nil {
 		return nil, err
 	}
@@ -504,8 +519,9 @@ func (t tournamentImpl) Category() (protocols.Category, error) {
 }
 
 // NewTournament ...
-func NewTournament(id protocols.URN, sportID protocols.URN, tournamentCache *TournamentCache, entityFactory protocols.EntityFactory, locales []protocols.Locale) protocols.Tournament {
+func NewTournament(ctx context.Context, id protocols.URN, sportID protocols.URN, tournamentCache *TournamentCache, entityFactory protocols.EntityFactory, locales []protocols.Locale) protocols.Tournament {
 	return &tournamentImpl{
+		ctx:             ctx,
 		id:              id,
 		sportID:         sportID,
 		tournamentCache: tournamentCache,
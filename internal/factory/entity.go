@@ -1,21 +1,37 @@
 package factory
 
 import (
+	"context"
+
 	"github.com/oddin-gg/gosdk/internal/cache"
 	"github.com/oddin-gg/gosdk/protocols"
 )
 
 // EntityFactory ...
 type EntityFactory struct {
+	ctx          context.Context
 	cacheManager *cache.Manager
 }
 
+// WithContext returns factory whose entities use ctx for API calls
+func (e *EntityFactory) WithContext(ctx context.Context) protocols.EntityFactory {
+	return e.withContext(ctx)
+}
+
+func (e *EntityFactory) withContext(ctx context.Context) *EntityFactory {
+	return &EntityFactory{
+		ctx:          ctx,
+		cacheManager: e.cacheManager,
+	}
+}
+
 // BuildTournaments ...
 func (e *EntityFactory) BuildTournaments(tournamentIDs []protocols.URN, sportID protocols.URN, locales []protocols.Locale) []protocols.Tournament {
 	result := make([]protocols.Tournament, len(tournamentIDs))
 	for i := range tournamentIDs {
 		id := tournamentIDs[i]
 		result[i] = cache.NewTournament(
+			e.ctx,
 			id,
 			sportID,
 			e.cacheManager.TournamentCache,
@@ -30,6 +46,7 @@ func (e *EntityFactory) BuildTournaments(tournamentIDs []protocols.URN, sportID
 // BuildTournament ...
 func (e *EntityFactory) BuildTournament(id protocols.URN, sportID protocols.URN, locales []protocols.Locale) protocols.Tournament {
 	return cache.NewTournament(
+		e.ctx,
 		id,
 		sportID,
 		e.cacheManager.TournamentCache,
@@ -40,7 +57,7 @@ func (e *EntityFactory) BuildTournament(id protocols.URN, sportID protocols.URN,
 
 // BuildSports ...
 func (e *EntityFactory) BuildSports(locales []protocols.Locale) ([]protocols.Sport, error) {
-	localizedSportIDs, err := e.cacheManager.SportDataCache.Sports(locales)
+	localizedSportIDs, err := e.cacheManager.SportDataCache.Sports(e.ctx, locales)
 	if err != nil {
 		return nil, err
 	}
@@ -48,7 +65,7 @@ func (e *EntityFactory) BuildSports(locales []protocols.Locale) ([]protocols.Spo
 	result := make([]protocols.Sport, len(localizedSportIDs))
 	for i := range localizedSportIDs {
 		id := localizedSportIDs[i]
-		result[i] = cache.NewSport(id, e.cacheManager.SportDataCache, e, locales)
+		result[i] = cache.NewSport(e.ctx, id, e.cacheManager.SportDataCache, e, locales)
 	}
 
 	return result, nil
@@ -56,7 +73,7 @@ func (e *EntityFactory) BuildSports(locales []protocols.Locale) ([]protocols.Spo
 
 // BuildSport ...
 func (e *EntityFactory) BuildSport(id protocols.URN, locales []protocols.Locale) protocols.Sport {
-	return cache.NewSport(id, e.cacheManager.SportDataCache, e, locales)
+	return cache.NewSport(e.ctx, id, e.cacheManager.SportDataCache, e, locales)
 }
 
 // BuildCompetitors ...
@@ -64,7 +81,7 @@ func (e *EntityFactory) BuildCompetitors(competitorIDs []protocols.URN, locales
 	result := make([]protocols.Competitor, len(competitorIDs))
 	for i := range competitorIDs {
 		id := competitorIDs[i]
-		result[i] = cache.NewCompetitor(id, e.cacheManager.CompetitorCache, e, locales)
+		result[i] = cache.NewCompetitor(e.ctx, id, e.cacheManager.CompetitorCache, e, locales)
 	}
 
 	return result
@@ -72,22 +89,22 @@ func (e *EntityFactory) BuildCompetitors(competitorIDs []protocols.URN, locales
 
 // BuildCompetitor ...
 func (e *EntityFactory) BuildCompetitor(id protocols.URN, locales []protocols.Locale) protocols.Competitor {
-	return cache.NewCompetitor(id, e.cacheManager.CompetitorCache, e, locales)
+	return cache.NewCompetitor(e.ctx, id, e.cacheManager.CompetitorCache, e, locales)
 }
 
 // BuildPlayer ...
 func (e *EntityFactory) BuildPlayer(id protocols.URN, locale protocols.Locale) protocols.Player {
-	return cache.NewPlayer(id, e.cacheManager.PlayersCache, locale)
+	return cache.NewPlayer(e.ctx, id, e.cacheManager.PlayersCache, locale)
 }
 
 // BuildFixture ...
 func (e *EntityFactory) BuildFixture(id protocols.URN, locales []protocols.Locale) protocols.Fixture {
-	return cache.NewFixture(id, e.cacheManager.FixtureCache, locales)
+	return cache.NewFixture(e.ctx, id, e.cacheManager.FixtureCache, locales)
 }
 
 // BuildMatchStatus ...
 func (e *EntityFactory) BuildMatchStatus(id protocols.URN, locales []protocols.Locale) protocols.MatchStatus {
-	return cache.NewMatchStatus(id, e.cacheManager.MatchStatusCache, e.cacheManager.LocalizedStaticMatchStatus, locales)
+	return cache.NewMatchStatus(e.ctx, id, e.cacheManager.MatchStatusCache, e.cacheManager.LocalizedStaticMatchStatus, locales)
 }
 
 // BuildMatches ...
@@ -95,7 +112,7 @@ func (e *EntityFactory) BuildMatches(ids []protocols.URN, locales []protocols.Lo
 	result := make([]protocols.Match, len(ids))
 	for i := range ids {
 		id := ids[i]
-		result[i] = cache.NewMatch(id, nil, e.cacheManager.MatchCache, e, locales)
+		result[i] = cache.NewMatch(e.ctx, id, nil, e.cacheManager.MatchCache, e, locales)
 	}
 
 	return result
@@ -103,12 +120,13 @@ func (e *EntityFactory) BuildMatches(ids []protocols.URN, locales []protocols.Lo
 
 // BuildMatch ...
 func (e *EntityFactory) BuildMatch(id protocols.URN, locales []protocols.Locale, sportID *protocols.URN) protocols.Match {
-	return cache.NewMatch(id, sportID, e.cacheManager.MatchCache, e, locales)
+	return cache.NewMatch(e.ctx, id, sportID, e.cacheManager.MatchCache, e, locales)
 }
 
 // NewEntityFactory ...
 func NewEntityFactory(cacheManager *cache.Manager) *EntityFactory {
 	return &EntityFactory{
+		ctx:          context.Background(),
 		cacheManager: cacheManager,
 	}
 }
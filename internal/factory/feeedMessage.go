@@ -1,8 +1,10 @@
 package factory
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	feedXML "github.com/oddin-gg/gosdk/internal/feed/xml"
@@ -11,10 +13,24 @@ import (
 
 // FeedMessageFactory ...
 type FeedMessageFactory struct {
-	entityFactory         *EntityFactory
-	marketFactory         *MarketFactory
-	producerManager       protocols.ProducerManager
-	oddsFeedConfiguration protocols.OddsFeedConfiguration
+	ctx                      context.Context
+	entityFactory            *EntityFactory
+	marketFactory            *MarketFactory
+	marketDescriptionFactory *MarketDescriptionFactory
+	producerManager          protocols.ProducerManager
+	oddsFeedConfiguration    protocols.OddsFeedConfiguration
+}
+
+// WithContext returns factory whose messages use ctx for API calls made by lazy lookups
+func (f *FeedMessageFactory) WithContext(ctx context.Context) *FeedMessageFactory {
+	return &FeedMessageFactory{
+		ctx:                      ctx,
+		entityFactory:            f.entityFactory.withContext(ctx),
+		marketFactory:            f.marketFactory.WithContext(ctx),
+		marketDescriptionFactory: f.marketDescriptionFactory,
+		producerManager:          f.producerManager,
+		oddsFeedConfiguration:    f.oddsFeedConfiguration,
+	}
 }
 
 // BuildMessage ...
@@ -24,7 +40,7 @@ func (f *FeedMessageFactory) BuildMessage(feedMessage *protocols.FeedMessage) (i
 	}
 
 	timestamp := feedMessage.Timestamp
-	timestamp.Published = time.Now()
+	timestamp.Published = f.oddsFeedConfiguration.Clock().Now()
 
 	var event interface{}
 	switch protocols.EventType(feedMessage.RoutingKey.EventID.Type) {
@@ -51,11 +67,15 @@ func (f *FeedMessageFactory) BuildMessage(feedMessage *protocols.FeedMessage) (i
 		}, nil
 	case *feedXML.BetStop:
 		return betStopImpl{
-			producer:   producer,
-			timestamp:  timestamp,
-			requestID:  msg.RequestID,
-			rawMessage: feedMessage.RawMessage,
-			event:      event,
+			producer:                 producer,
+			timestamp:                timestamp,
+			requestID:                msg.RequestID,
+			rawMessage:               feedMessage.RawMessage,
+			event:                    event,
+			message:                  msg,
+			ctx:                      f.ctx,
+			marketDescriptionFactory: f.marketDescriptionFactory,
+			locale:                   f.oddsFeedConfiguration.DefaultLocale(),
 		}, nil
 	case *feedXML.BetSettlement:
 		return betSettlementImpl{
@@ -109,7 +129,7 @@ func (f *FeedMessageFactory) BuildMessage(feedMessage *protocols.FeedMessage) (i
 // BuildUnparsableMessage ...
 func (f *FeedMessageFactory) BuildUnparsableMessage(feedMessage *protocols.FeedMessage) protocols.UnparsableMessage {
 	timestamp := feedMessage.Timestamp
-	timestamp.Published = time.Now()
+	timestamp.Published = f.oddsFeedConfiguration.Clock().Now()
 
 	var event interface{}
 	switch protocols.EventType(feedMessage.RoutingKey.EventID.Type) {
@@ -147,13 +167,72 @@ func (f *FeedMessageFactory) BuildProducerStatus(producerID uint, producerStatus
 	}, nil
 }
 
+// BuildSyntheticBetStop ...
+func (f *FeedMessageFactory) BuildSyntheticBetStop(
+	producer protocols.Producer,
+	eventID protocols.URN,
+	sportID *protocols.URN,
+	reason protocols.ProducerStatusReason,
+	timestamp protocols.MessageTimestamp,
+) protocols.SyntheticBetStop {
+	return syntheticBetStopImpl{
+		producer:                 producer,
+		timestamp:                timestamp,
+		event:                    f.buildEvent(eventID, sportID),
+		reason:                   reason,
+		ctx:                      f.ctx,
+		marketDescriptionFactory: f.marketDescriptionFactory,
+		locale:                   f.oddsFeedConfiguration.DefaultLocale(),
+	}
+}
+
+// BuildSyntheticBetStart ...
+func (f *FeedMessageFactory) BuildSyntheticBetStart(
+	producer protocols.Producer,
+	eventID protocols.URN,
+	sportID *protocols.URN,
+	reason protocols.ProducerStatusReason,
+	timestamp protocols.MessageTimestamp,
+) protocols.SyntheticBetStart {
+	return syntheticBetStartImpl{
+		producer:  producer,
+		timestamp: timestamp,
+		event:     f.buildEvent(eventID, sportID),
+		reason:    reason,
+	}
+}
+
+func (f *FeedMessageFactory) buildEvent(eventID protocols.URN, sportID *protocols.URN) interface{} {
+	locales := []protocols.Locale{f.oddsFeedConfiguration.DefaultLocale()}
+
+	switch protocols.EventType(eventID.Type) {
+	case protocols.TournamentEventType:
+		if sportID == nil {
+			return nil
+		}
+		return f.entityFactory.BuildTournament(eventID, *sportID, locales)
+	case protocols.MatchEventType:
+		return f.entityFactory.BuildMatch(eventID, locales, sportID)
+	default:
+		return nil
+	}
+}
+
 // NewFeedMessageFactory ...
-func NewFeedMessageFactory(entityFactory *EntityFactory, marketFactory *MarketFactory, producerManager protocols.ProducerManager, oddsFeedConfiguration protocols.OddsFeedConfiguration) *FeedMessageFactory {
+func NewFeedMessageFactory(
+	entityFactory *EntityFactory,
+	marketFactory *MarketFactory,
+	marketDescriptionFactory *MarketDescriptionFactory,
+	producerManager protocols.ProducerManager,
+	oddsFeedConfiguration protocols.OddsFeedConfiguration,
+) *FeedMessageFactory {
 	return &FeedMessageFactory{
-		entityFactory:         entityFactory,
-		marketFactory:         marketFactory,
-		producerManager:       producerManager,
-		oddsFeedConfiguration: oddsFeedConfiguration,
+		ctx:                      context.Background(),
+		entityFactory:            entityFactory,
+		marketFactory:            marketFactory,
+		marketDescriptionFactory: marketDescriptionFactory,
+		producerManager:          producerManager,
+		oddsFeedConfiguration:    oddsFeedConfiguration,
 	}
 }
 
@@ -251,11 +330,15 @@ func (m oddsChangeImpl) Markets() []protocols.MarketWithOdds {
 }
 
 type betStopImpl struct {
-	producer   protocols.Producer
-	timestamp  protocols.MessageTimestamp
-	requestID  *uint
-	rawMessage []byte
-	event      interface{}
+	producer                 protocols.Producer
+	timestamp                protocols.MessageTimestamp
+	requestID                *uint
+	rawMessage               []byte
+	event                    interface{}
+	message                  *feedXML.BetStop
+	ctx                      context.Context
+	marketDescriptionFactory *MarketDescriptionFactory
+	locale                   protocols.Locale
 }
 
 func (b betStopImpl) Producer() protocols.Producer {
@@ -278,6 +361,26 @@ func (b betStopImpl) Event() interface{} {
 	return b.event
 }
 
+func (b betStopImpl) Groups() []string {
+	if len(b.message.Groups) == 0 {
+		return nil
+	}
+
+	return strings.Split(b.message.Groups, "|")
+}
+
+func (b betStopImpl) MarketStatus() protocols.MarketStatus {
+	if b.message.Status == nil {
+		return protocols.SuspendedMarketStatus
+	}
+
+	return ConvertFeedMarketStatus(b.message.Status)
+}
+
+func (b betStopImpl) AffectedMarkets() ([]protocols.MarketDescription, error) {
+	return b.marketDescriptionFactory.MarketDescriptionsByGroups(b.ctx, b.Groups(), b.locale)
+}
+
 type betSettlementImpl struct {
 	producer      protocols.Producer
 	timestamp     protocols.MessageTimestamp
@@ -520,3 +623,80 @@ func (m rollbackBetCancelImpl) EndTime() *time.Time {
 	endTime := time.Unix(int64(*m.message.EndTime), 0)
 	return &endTime
 }
+
+type syntheticBetStopImpl struct {
+	producer                 protocols.Producer
+	timestamp                protocols.MessageTimestamp
+	event                    interface{}
+	reason                   protocols.ProducerStatusReason
+	ctx                      context.Context
+	marketDescriptionFactory *MarketDescriptionFactory
+	locale                   protocols.Locale
+}
+
+func (s syntheticBetStopImpl) Producer() protocols.Producer {
+	return s.producer
+}
+
+func (s syntheticBetStopImpl) Timestamp() protocols.MessageTimestamp {
+	return s.timestamp
+}
+
+func (s syntheticBetStopImpl) RequestID() *uint {
+	return nil
+}
+
+func (s syntheticBetStopImpl) RawMessage() []byte {
+	return nil
+}
+
+func (s syntheticBetStopImpl) Event() interface{} {
+	return s.event
+}
+
+func (s syntheticBetStopImpl) Groups() []string {
+	return []string{protocols.MarketGroupAll}
+}
+
+func (s syntheticBetStopImpl) MarketStatus() protocols.MarketStatus {
+	return protocols.SuspendedMarketStatus
+}
+
+func (s syntheticBetStopImpl) AffectedMarkets() ([]protocols.MarketDescription, error) {
+	return s.marketDescriptionFactory.MarketDescriptionsByGroups(s.ctx, s.Groups(), s.locale)
+}
+
+func (s syntheticBetStopImpl) IsSDKGenerated() bool {
+	return true
+}
+
+func (s syntheticBetStopImpl) Reason() protocols.ProducerStatusReason {
+	return s.reason
+}
+
+type syntheticBetStartImpl struct {
+	producer  protocols.Producer
+	timestamp protocols.MessageTimestamp
+	event     interface{}
+	reason    protocols.ProducerStatusReason
+}
+
+func (s syntheticBetStartImpl) Producer() protocols.Producer {
+	return s.producer
+}
+
+func (s syntheticBetStartImpl) Timestamp() protocols.MessageTimestamp {
+	return s.timestamp
+}
+
+func (s syntheticBetStartImpl) Event() interface{} {
+	return s.event
+}
+
+func (s syntheticBetStartImpl) IsSDKGenerated() bool {
+	return true
+}
+
+func (s syntheticBetStartImpl) Reason() protocols.ProducerStatusReason {
+	return s.reason
+}
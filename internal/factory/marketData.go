@@ -1,6 +1,7 @@
 package factory
 
 import (
+	"context"
 	"fmt"
 	"slices"
 	"strings"
@@ -11,13 +12,21 @@ import (
 
 // MarketDataFactory ...
 type MarketDataFactory struct {
+	ctx                      context.Context
 	oddsFeedConfiguration    protocols.OddsFeedConfiguration
 	marketDescriptionFactory *MarketDescriptionFactory
 }
 
+// WithContext returns factory whose market data use ctx for API calls
+func (m MarketDataFactory) WithContext(ctx context.Context) *MarketDataFactory {
+	m.ctx = ctx
+	return &m
+}
+
 // BuildMarketData ...
 func (m MarketDataFactory) BuildMarketData(event interface{}, marketID uint, specifiers map[string]string) protocols.MarketData {
 	return &marketDataImpl{
+		ctx:                      m.ctx,
 		marketID:                 marketID,
 		specifiers:               specifiers,
 		marketDescriptionFactory: m.marketDescriptionFactory,
@@ -28,12 +37,14 @@ func (m MarketDataFactory) BuildMarketData(event interface{}, marketID uint, spe
 // NewMarketDataFactory ...
 func NewMarketDataFactory(oddsFeedConfiguration protocols.OddsFeedConfiguration, marketDescriptionFactory *MarketDescriptionFactory) *MarketDataFactory {
 	return &MarketDataFactory{
+		ctx:                      context.Background(),
 		oddsFeedConfiguration:    oddsFeedConfiguration,
 		marketDescriptionFactory: marketDescriptionFactory,
 	}
 }
 
 type marketDataImpl struct {
+	ctx                      context.Context
 	marketID                 uint
 	specifiers               map[string]string
 	marketDescriptionFactory *MarketDescriptionFactory
@@ -41,7 +52,7 @@ type marketDataImpl struct {
 }
 
 func (m marketDataImpl) OutcomeName(outcomeID string, locale protocols.Locale) (*string, error) {
-	marketDescription, err := m.marketDescriptionFactory.MarketDescriptionByIDAndSpecifiers(m.marketID, m.specifiers, []protocols.Locale{locale})
+	marketDescription, err := m.marketDescriptionFactory.MarketDescriptionByIDAndSpecifiers(m.ctx, m.marketID, m.specifiers, []protocols.Locale{locale})
 	if err != nil {
 		return nil, err
 	}
@@ -65,7 +76,7 @@ func (m marketDataImpl) OutcomeName(outcomeID string, locale protocols.Locale) (
 	if !found && marketDescription.OutcomeType() != nil {
 		switch outcomeType(*marketDescription.OutcomeType()) {
 		case playerOutcomeType:
-			player, err := m.marketDescriptionFactory.playerCache.GetPlayer(cache.PlayerCacheKey{PlayerID: outcomeID, Locale: locale})
+			player, err := m.marketDescriptionFactory.playerCache.GetPlayer(m.ctx, cache.PlayerCacheKey{PlayerID: outcomeID, Locale: locale})
 			if err != nil {
 				return nil, fmt.Errorf("derivation of outcome name for dynamic player outcome failed for id [%s]: %w", outcomeID, err)
 			}
@@ -76,7 +87,7 @@ func (m marketDataImpl) OutcomeName(outcomeID string, locale protocols.Locale) (
 			if err != nil {
 				return nil, fmt.Errorf("unsupported competitor id in outcome %s: %w", outcomeID, err)
 			}
-			competitor, err := m.marketDescriptionFactory.competitorCache.Competitor(*urn, []protocols.Locale{locale})
+			competitor, err := m.marketDescriptionFactory.competitorCache.Competitor(m.ctx, *urn, []protocols.Locale{locale})
 			if err != nil {
 				return nil, fmt.Errorf("derivation of outcome name for dynamic player outcome failed for id [%s]: %w", outcomeID, err)
 			}
@@ -97,7 +108,7 @@ func (m marketDataImpl) OutcomeName(outcomeID string, locale protocols.Locale) (
 }
 
 func (m marketDataImpl) MarketName(locale protocols.Locale) (*string, error) {
-	marketDescription, err := m.marketDescriptionFactory.MarketDescriptionByIDAndSpecifiers(m.marketID, m.specifiers, []protocols.Locale{locale})
+	marketDescription, err := m.marketDescriptionFactory.MarketDescriptionByIDAndSpecifiers(m.ctx, m.marketID, m.specifiers, []protocols.Locale{locale})
 	if err != nil {
 		return nil, err
 	}
@@ -143,7 +154,7 @@ func (m marketDataImpl) makeMarketName(marketName string, locale protocols.Local
 	}
 
 	match, isMatch := m.event.(protocols.Match)
-	marketDescription, err := m.marketDescriptionFactory.MarketDescriptionByIDAndSpecifiers(m.marketID, m.specifiers, []protocols.Locale{locale})
+	marketDescription, err := m.marketDescriptionFactory.MarketDescriptionByIDAndSpecifiers(m.ctx, m.marketID, m.specifiers, []protocols.Locale{locale})
 	if err != nil {
 		return nil, err
 	}
@@ -207,6 +218,7 @@ func (m marketDataImpl) getPropsName(entityID string, groups []string, locale pr
 	switch urn.Type {
 	case string(protocols.PlayerEventType):
 		player, err := m.marketDescriptionFactory.playerCache.GetPlayer(
+			m.ctx,
 			cache.PlayerCacheKey{
 				PlayerID: entityID,
 				Locale:   locale,
@@ -1,8 +1,10 @@
 package factory
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/oddin-gg/gosdk/internal/cache"
 	"github.com/oddin-gg/gosdk/protocols"
@@ -18,6 +20,7 @@ type MarketDescriptionFactory struct {
 
 // MarketDescriptionByIDAndSpecifiers returns market description from cache based on marketID, specifiers and locales
 func (m MarketDescriptionFactory) MarketDescriptionByIDAndSpecifiers(
+	ctx context.Context,
 	marketID uint,
 	specifiers map[string]string,
 	locales []protocols.Locale,
@@ -28,17 +31,18 @@ func (m MarketDescriptionFactory) MarketDescriptionByIDAndSpecifiers(
 		variant = &specifier
 	}
 
-	return m.MarketDescriptionByIDAndVariant(marketID, variant, locales)
+	return m.MarketDescriptionByIDAndVariant(ctx, marketID, variant, locales)
 }
 
 // MarketDescriptionByIDAndVariant returns market description from cache based on marketID, optional market variant
 // and locales
 func (m MarketDescriptionFactory) MarketDescriptionByIDAndVariant(
+	ctx context.Context,
 	marketID uint,
 	variant *string,
 	locales []protocols.Locale,
 ) (protocols.MarketDescription, error) {
-	mds, err := m.marketDescriptionCache.MarketDescriptionByID(marketID, variant, locales)
+	mds, err := m.marketDescriptionCache.MarketDescriptionByID(ctx, marketID, variant, locales)
 	if err != nil {
 		return nil, fmt.Errorf("get market description by id failed: %w", err)
 	}
@@ -46,12 +50,12 @@ func (m MarketDescriptionFactory) MarketDescriptionByIDAndVariant(
 		return nil, errors.New("get market description by id failed - cannot be nil")
 	}
 
-	return cache.NewMarketDescription(marketID, mds.IncludesOutcomesOfType, mds.OutcomeType, variant, m.marketDescriptionCache, locales), nil
+	return cache.NewMarketDescription(ctx, marketID, mds.IncludesOutcomesOfType, mds.OutcomeType, variant, m.marketDescriptionCache, locales), nil
 }
 
 // MarketVoidReasons ...
-func (m MarketDescriptionFactory) MarketVoidReasons() ([]protocols.MarketVoidReason, error) {
-	data, err := m.marketVoidReasonsCache.MarketVoidReasons()
+func (m MarketDescriptionFactory) MarketVoidReasons(ctx context.Context) ([]protocols.MarketVoidReason, error) {
+	data, err := m.marketVoidReasonsCache.MarketVoidReasons(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -78,17 +82,17 @@ func (m MarketDescriptionFactory) MarketVoidReasons() ([]protocols.MarketVoidRea
 }
 
 // ReloadMarketVoidReasons ...
-func (m MarketDescriptionFactory) ReloadMarketVoidReasons() ([]protocols.MarketVoidReason, error) {
-	if err := m.marketVoidReasonsCache.ReloadMarketVoidReasons(); err != nil {
+func (m MarketDescriptionFactory) ReloadMarketVoidReasons(ctx context.Context) ([]protocols.MarketVoidReason, error) {
+	if err := m.marketVoidReasonsCache.ReloadMarketVoidReasons(ctx); err != nil {
 		return nil, err
 	}
 
-	return m.MarketVoidReasons()
+	return m.MarketVoidReasons(ctx)
 }
 
 // MarketDescriptions ...
-func (m MarketDescriptionFactory) MarketDescriptions(locale protocols.Locale) ([]protocols.MarketDescription, error) {
-	marketDescriptions, err := m.marketDescriptionCache.LocalizedMarketDescriptions(locale)
+func (m MarketDescriptionFactory) MarketDescriptions(ctx context.Context, locale protocols.Locale) ([]protocols.MarketDescription, error) {
+	marketDescriptions, err := m.marketDescriptionCache.LocalizedMarketDescriptions(ctx, locale)
 	if err != nil {
 		return nil, err
 	}
@@ -96,6 +100,7 @@ func (m MarketDescriptionFactory) MarketDescriptions(locale protocols.Locale) ([
 	result := make([]protocols.MarketDescription, 0, len(marketDescriptions))
 	for key, value := range marketDescriptions {
 		description := cache.NewMarketDescription(
+			ctx,
 			key.MarketID,
 			value.IncludesOutcomesOfType,
 			value.OutcomeType,
@@ -109,6 +114,39 @@ func (m MarketDescriptionFactory) MarketDescriptions(locale protocols.Locale) ([
 	return result, nil
 }
 
+// MarketDescriptionsByGroups returns market descriptions which groups intersect given groups, MarketGroupAll
+// matches all market descriptions
+func (m MarketDescriptionFactory) MarketDescriptionsByGroups(
+	ctx context.Context,
+	groups []string,
+	locale protocols.Locale,
+) ([]protocols.MarketDescription, error) {
+	marketDescriptions, err := m.MarketDescriptions(ctx, locale)
+	if err != nil {
+		return nil, err
+	}
+
+	if slices.Contains(groups, protocols.MarketGroupAll) {
+		return marketDescriptions, nil
+	}
+
+	result := make([]protocols.MarketDescription, 0)
+	for _, marketDescription := range marketDescriptions {
+		marketGroups, err := marketDescription.Groups()
+		if err != nil {
+			return nil, err
+		}
+
+		if slices.ContainsFunc(marketGroups, func(group string) bool {
+			return slices.Contains(groups, group)
+		}) {
+			result = append(result, marketDescription)
+		}
+	}
+
+	return result, nil
+}
+
 // NewMarketDescriptionFactory ...
 func NewMarketDescriptionFactory(
 	marketDescriptionCache *cache.MarketDescriptionCache,
@@ -1,6 +1,7 @@
 package factory
 
 import (
+	"context"
 	"strings"
 
 	feedXML "github.com/oddin-gg/gosdk/internal/feed/xml"
@@ -15,6 +16,12 @@ type MarketFactory struct {
 	logger            *log.Entry
 }
 
+// WithContext returns factory whose markets use ctx for API calls
+func (m MarketFactory) WithContext(ctx context.Context) *MarketFactory {
+	m.marketDataFactory = m.marketDataFactory.WithContext(ctx)
+	return &m
+}
+
 // BuildMarket ...
 func (m MarketFactory) BuildMarket(event interface{}, market *feedXML.MarketAttributes) protocols.Market {
 	specifiersMap := m.extractSpecifiers(market.Specifiers)
@@ -2,14 +2,18 @@ package feed
 
 import (
 	"encoding/xml"
+	"errors"
 	"fmt"
+	"slices"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/oddin-gg/gosdk/internal/factory"
 	feedXML "github.com/oddin-gg/gosdk/internal/feed/xml"
+	"github.com/oddin-gg/gosdk/internal/journal"
 	"github.com/oddin-gg/gosdk/protocols"
-	amqp "github.com/rabbitmq/amqp091-go"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -31,7 +35,11 @@ type envelope struct {
 
 // ChannelConsumer ...
 type ChannelConsumer struct {
-	client             *Client
+	lock               sync.Mutex
+	transport          protocols.Transport
+	channel            protocols.TransportChannel
+	journal            *journal.Writer
+	sessionIndex       int
 	outgoing           chan *protocols.QueueMessage
 	feedMessageFactory *factory.FeedMessageFactory
 	logger             *log.Entry
@@ -39,20 +47,48 @@ type ChannelConsumer struct {
 	sportIDPrefix      string
 	messageInterest    *protocols.MessageInterest
 	routingKeys        []string
-	closed             bool
+	// unbound holds keys which failed to unbind, durable queue keeps them bound across reconnects
+	unbound        []string
+	channelOptions protocols.ChannelOptions
+	queue          *deliveryQueue
+	clock          protocols.Clock
+	connectedCh    chan struct{}
+	closed         atomic.Bool
 }
 
 // Open ...
-func (c *ChannelConsumer) Open(routingKeys []string, messageInterest *protocols.MessageInterest) (chan *protocols.QueueMessage, error) {
-	ch, err := c.client.CreateChannel(routingKeys, c.exchangeName)
+func (c *ChannelConsumer) Open(
+	routingKeys []string,
+	messageInterest *protocols.MessageInterest,
+	channelOptions protocols.ChannelOptions,
+) (chan *protocols.QueueMessage, error) {
+	ch, err := c.transport.CreateChannel(c.exchangeName, routingKeys, channelOptions)
 	if err != nil {
 		return nil, err
 	}
 
-	c.routingKeys = routingKeys
+	c.lock.Lock()
+	c.channel = ch
+	c.routingKeys = append([]string(nil), routingKeys...)
+	c.lock.Unlock()
+
+	c.channelOptions = channelOptions
 	c.messageInterest = messageInterest
 	c.outgoing = make(chan *protocols.QueueMessage)
 
+	if c.queue != nil {
+		go func() {
+			for {
+				msg, ok := c.queue.pop()
+				if !ok {
+					return
+				}
+
+				c.processMessage(msg)
+			}
+		}()
+	}
+
 	c.consumeMessage(ch)
 
 	return c.outgoing, nil
@@ -60,56 +96,185 @@ func (c *ChannelConsumer) Open(routingKeys []string, messageInterest *protocols.
 
 // Close ...
 func (c *ChannelConsumer) Close() {
-	c.closed = true
+	c.closed.Store(true)
+
+	if c.queue != nil {
+		c.queue.close()
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.channel != nil {
+		_ = c.channel.Close()
+	}
 }
 
-func (c *ChannelConsumer) reconnect() {
-	if c.closed {
-		return
+// ConnectionRestored makes pending channel reconnect retry immediately instead of waiting for its backoff
+func (c *ChannelConsumer) ConnectionRestored() {
+	select {
+	case c.connectedCh <- struct{}{}:
+	default:
+	}
+}
+
+// Bind adds routing keys to the channel. Keys are recorded even when the channel is down at the moment,
+// they are bound when the channel reconnects.
+func (c *ChannelConsumer) Bind(routingKeys []string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.channel == nil {
+		return errors.New("channel is not opened")
 	}
 
+	for _, routingKey := range routingKeys {
+		if slices.Contains(c.routingKeys, routingKey) {
+			continue
+		}
+
+		c.routingKeys = append(c.routingKeys, routingKey)
+		c.unbound = slices.DeleteFunc(c.unbound, func(key string) bool {
+			return key == routingKey
+		})
+
+		err := c.channel.Bind(routingKey)
+		if err != nil {
+			c.logger.WithError(err).Warnf("failed to bind %s, it will be bound on reconnect", routingKey)
+		}
+	}
+
+	return nil
+}
+
+// Unbind removes routing keys from the channel, keys failed to unbind are unbound again on reconnect
+func (c *ChannelConsumer) Unbind(routingKeys []string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.channel == nil {
+		return errors.New("channel is not opened")
+	}
+
+	for _, routingKey := range routingKeys {
+		if !slices.Contains(c.routingKeys, routingKey) {
+			continue
+		}
+
+		c.routingKeys = slices.DeleteFunc(c.routingKeys, func(key string) bool {
+			return key == routingKey
+		})
+
+		err := c.channel.Unbind(routingKey)
+		if err != nil {
+			c.logger.WithError(err).Warnf("failed to unbind %s, it will be unbound on reconnect", routingKey)
+			c.unbound = append(c.unbound, routingKey)
+		}
+	}
+
+	return nil
+}
+
+func (c *ChannelConsumer) reconnect() {
 	c.logger.Warnf("channel closed, trying reconnect...")
 
-	ch, err := c.client.CreateChannel(c.routingKeys, c.exchangeName)
-	if err != nil {
-		c.logger.WithError(err).Error("failed to reconnect channel, retrying ...")
-		time.Sleep(5 * time.Second)
-		go c.reconnect()
-		return
+	for attempt := uint(1); !c.closed.Load(); attempt++ {
+		c.lock.Lock()
+		routingKeys := slices.Clone(c.routingKeys)
+		c.lock.Unlock()
+
+		ch, err := c.transport.CreateChannel(c.exchangeName, routingKeys, c.channelOptions)
+		if err == nil {
+			c.lock.Lock()
+			c.applyBindings(ch, routingKeys)
+			c.channel = ch
+			c.lock.Unlock()
+
+			c.consumeMessage(ch)
+			return
+		}
+
+		delay := reconnectDelay(attempt)
+		c.logger.WithError(err).Errorf("failed to reconnect channel, retrying in %s ...", delay)
+
+		select {
+		case <-time.After(delay):
+		case <-c.connectedCh:
+			attempt = 0
+		}
 	}
+}
 
-	c.consumeMessage(ch)
+// applyBindings updates new channel with bindings changed while it was being created and removes bindings which
+// failed to unbind before, it has to be called with lock held
+func (c *ChannelConsumer) applyBindings(ch protocols.TransportChannel, bound []string) {
+	for _, routingKey := range c.routingKeys {
+		if slices.Contains(bound, routingKey) {
+			continue
+		}
+
+		err := ch.Bind(routingKey)
+		if err != nil {
+			c.logger.WithError(err).Warnf("failed to bind %s after reconnect", routingKey)
+		}
+	}
+
+	for _, routingKey := range bound {
+		if !slices.Contains(c.routingKeys, routingKey) && !slices.Contains(c.unbound, routingKey) {
+			c.unbound = append(c.unbound, routingKey)
+		}
+	}
+
+	c.unbound = slices.DeleteFunc(c.unbound, func(routingKey string) bool {
+		err := ch.Unbind(routingKey)
+		if err != nil {
+			c.logger.WithError(err).Warnf("failed to unbind %s after reconnect", routingKey)
+			return false
+		}
+
+		return true
+	})
 }
 
-func (c *ChannelConsumer) consumeMessage(ch <-chan amqp.Delivery) {
+func (c *ChannelConsumer) consumeMessage(ch protocols.TransportChannel) {
 	go func() {
-		for msg := range ch {
-			if c.closed {
+		for msg := range ch.Deliveries() {
+			switch {
+			case c.closed.Load():
 				return
+			case c.queue != nil:
+				c.queue.push(msg)
+			default:
+				c.processMessage(msg)
 			}
-
-			c.processMessage(msg)
 		}
 
 		c.reconnect()
 	}()
 }
 
-func (c *ChannelConsumer) processMessage(msg amqp.Delivery) {
+func (c *ChannelConsumer) processMessage(msg protocols.TransportDelivery) {
 	timestamp := protocols.MessageTimestamp{
 		Created:   msg.Timestamp,
 		Sent:      msg.Timestamp,
-		Received:  time.Now(),
+		Received:  c.clock.Now(),
 		Published: time.Time{},
 	}
 
+	if c.journal != nil {
+		c.record(msg, timestamp.Received)
+	}
+
 	routingKeyInfo, err := c.parseRoute(msg.RoutingKey)
 	if err != nil {
 		c.logger.WithError(err).Errorf("failed to parse route %s", msg.RoutingKey)
+		c.ack(msg)
 		return
 	}
 
-	queueMessage := &protocols.QueueMessage{}
+	queueMessage := &protocols.QueueMessage{
+		Acknowledger: msg.Acknowledger,
+	}
 
 	if msg.Body == nil || len(msg.Body) == 0 {
 		c.logger.Warnf("received message without proper body from %s", msg.RoutingKey)
@@ -144,7 +309,7 @@ func (c *ChannelConsumer) processMessage(msg amqp.Delivery) {
 		return
 	}
 
-	timestamp.Published = time.Now()
+	timestamp.Published = c.clock.Now()
 	basicMessage := protocols.BasicFeedMessage{
 		RawMessage: msg.Body,
 		RoutingKey: routingKeyInfo,
@@ -200,6 +365,31 @@ func (c *ChannelConsumer) processMessage(msg amqp.Delivery) {
 	c.outgoing <- queueMessage
 }
 
+func (c *ChannelConsumer) record(msg protocols.TransportDelivery, receivedAt time.Time) {
+	err := c.journal.Write(journal.Record{
+		RoutingKey:      msg.RoutingKey,
+		Timestamp:       msg.Timestamp,
+		ReceivedAt:      receivedAt,
+		Body:            msg.Body,
+		MessageInterest: c.messageInterest.Name(),
+		Session:         c.sessionIndex,
+	})
+	if err != nil {
+		c.logger.WithError(err).Errorf("failed to record message from %s", msg.RoutingKey)
+	}
+}
+
+func (c *ChannelConsumer) ack(msg protocols.TransportDelivery) {
+	if msg.Acknowledger == nil {
+		return
+	}
+
+	err := msg.Acknowledger.Ack()
+	if err != nil {
+		c.logger.WithError(err).Errorf("failed to ack message from %s", msg.RoutingKey)
+	}
+}
+
 func (c *ChannelConsumer) parseRoute(route string) (*protocols.RoutingKeyInfo, error) {
 	parts := strings.Split(route, ".")
 	if len(parts) != 8 {
@@ -251,17 +441,31 @@ func (c *ChannelConsumer) parseRoute(route string) (*protocols.RoutingKeyInfo, e
 
 // NewChannelConsumer ...
 func NewChannelConsumer(
-	client *Client,
+	transport protocols.Transport,
+	journal *journal.Writer,
+	sessionIndex int,
 	feedMessageFactory *factory.FeedMessageFactory,
 	logger *log.Entry,
 	exchangeName string,
 	sportIDPrefix string,
+	overflowParams protocols.OverflowParams,
+	clock protocols.Clock,
 ) *ChannelConsumer {
+	var queue *deliveryQueue
+	if overflowParams.Capacity > 0 {
+		queue = newDeliveryQueue(overflowParams, logger)
+	}
+
 	return &ChannelConsumer{
-		client:             client,
+		queue:              queue,
+		transport:          transport,
+		journal:            journal,
+		sessionIndex:       sessionIndex,
 		feedMessageFactory: feedMessageFactory,
 		logger:             logger,
 		exchangeName:       exchangeName,
 		sportIDPrefix:      sportIDPrefix,
+		clock:              clock,
+		connectedCh:        make(chan struct{}, 1),
 	}
 }
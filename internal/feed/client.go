@@ -3,6 +3,7 @@ package feed
 import (
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/oddin-gg/gosdk/protocols"
@@ -16,6 +17,10 @@ type Client struct {
 	oddsFeedConfiguration protocols.OddsFeedConfiguration
 	whoAmIManager         protocols.WhoAmIManager
 	logger                *log.Entry
+	lock                  sync.RWMutex
+	state                 protocols.ConnectionState
+	statusCh              chan protocols.ConnectionStatus
+	closeCh               chan struct{}
 	closed                bool
 }
 
@@ -25,29 +30,49 @@ func NewClient(oddsFeedConfiguration protocols.OddsFeedConfiguration, whoAmIMana
 		oddsFeedConfiguration: oddsFeedConfiguration,
 		whoAmIManager:         whoAmIManager,
 		logger:                logger,
+		statusCh:              make(chan protocols.ConnectionStatus, connectionStatusBuffer),
+		closeCh:               make(chan struct{}),
 	}
 }
 
 // CreateChannel ...
-func (c *Client) CreateChannel(routingKeys []string, exchangeName string) (<-chan amqp.Delivery, error) {
-	if c.connection == nil {
+func (c *Client) CreateChannel(exchangeName string, routingKeys []string, options protocols.ChannelOptions) (protocols.TransportChannel, error) {
+	c.lock.RLock()
+	connection := c.connection
+	state := c.state
+	c.lock.RUnlock()
+
+	switch {
+	case connection == nil:
 		return nil, errors.New("connection is not opened")
+	case state != protocols.ConnectedConnectionState:
+		return nil, errors.New("connection is not established")
 	}
 
-	channel, err := c.connection.Channel()
+	channel, err := connection.Channel()
 	if err != nil {
 		return nil, err
 	}
 
+	if options.PrefetchCount > 0 {
+		err = channel.Qos(options.PrefetchCount, 0, false)
+		if err != nil {
+			_ = channel.Close()
+			return nil, err
+		}
+	}
+
+	named := len(options.QueueName) != 0
 	queue, err := channel.QueueDeclare(
-		"",    // name
-		false, // durable
-		true,  // delete when unused
-		true,  // exclusive
-		false, // no-wait
-		nil,   // arguments
+		options.QueueName, // name
+		named,             // durable
+		!named,            // delete when unused
+		!named,            // exclusive
+		false,             // no-wait
+		nil,               // arguments
 	)
 	if err != nil {
+		_ = channel.Close()
 		return nil, err
 	}
 
@@ -59,23 +84,44 @@ func (c *Client) CreateChannel(routingKeys []string, exchangeName string) (<-cha
 			false,
 			nil)
 		if err != nil {
+			_ = channel.Close()
 			return nil, err
 		}
 	}
 
-	return channel.Consume(
+	deliveries, err := channel.Consume(
 		queue.Name,
 		"",
-		true,
-		true,
+		!options.ManualAck,
+		!named,
 		false,
 		false,
 		nil)
+	if err != nil {
+		_ = channel.Close()
+		return nil, err
+	}
+
+	return newAMQPChannel(channel, queue.Name, exchangeName, deliveries, options.ManualAck), nil
+}
+
+// ConnectionStatusCh ...
+func (c *Client) ConnectionStatusCh() <-chan protocols.ConnectionStatus {
+	return c.statusCh
 }
 
 // Close ...
 func (c *Client) Close() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.closed {
+		return
+	}
+
 	c.closed = true
+	c.setState(protocols.ClosedConnectionState, 0, nil)
+	close(c.closeCh)
 
 	if c.connection != nil {
 		_ = c.connection.Close()
@@ -84,14 +130,32 @@ func (c *Client) Close() {
 
 // Open ...
 func (c *Client) Open() error {
-	mqURL, err := c.oddsFeedConfiguration.MQURL()
+	c.lock.Lock()
+	if c.closed {
+		c.lock.Unlock()
+		return errors.New("client is closed")
+	}
+	c.setState(protocols.ConnectingConnectionState, 0, nil)
+	c.lock.Unlock()
+
+	connection, err := c.dial()
 	if err != nil {
 		return err
 	}
 
+	c.connected(connection)
+	return nil
+}
+
+func (c *Client) dial() (*amqp.Connection, error) {
+	mqURL, err := c.oddsFeedConfiguration.MQURL()
+	if err != nil {
+		return nil, err
+	}
+
 	details, err := c.whoAmIManager.BookmakerDetails()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	vHost := details.VirtualHost()
@@ -105,39 +169,157 @@ func (c *Client) Open() error {
 	properties := make(map[string]interface{})
 	properties["SDK"] = "go"
 
-	c.connection, err = amqp.DialConfig(amqpURL,
+	return amqp.DialConfig(amqpURL,
 		amqp.Config{
 			Vhost:      vHost,
 			Properties: properties,
 		},
 	)
-	if err != nil {
-		return err
+}
+
+func (c *Client) connected(connection *amqp.Connection) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.closed {
+		_ = connection.Close()
+		return
 	}
 
+	c.connection = connection
+	c.setState(protocols.ConnectedConnectionState, 0, nil)
+
 	errorCh := make(chan *amqp.Error, 1)
 	go func() {
-		for err := range errorCh {
-			switch {
-			case err == nil:
-				continue
-			case c.closed:
-				return
+		err, ok := <-errorCh
+		if !ok || err == nil {
+			// graceful close
+			return
+		}
+
+		c.reconnect(err)
+	}()
+	connection.NotifyClose(errorCh)
+}
+
+func (c *Client) reconnect(cause error) {
+	for attempt := uint(1); ; attempt++ {
+		c.lock.Lock()
+		if c.closed {
+			c.lock.Unlock()
+			return
+		}
+		c.setState(protocols.ReconnectingConnectionState, attempt, cause)
+		c.lock.Unlock()
+
+		delay := reconnectDelay(attempt)
+		c.logger.WithError(cause).Warnf("rabbitmq connection lost, reconnecting in %s (attempt %d)", delay, attempt)
+
+		select {
+		case <-time.After(delay):
+		case <-c.closeCh:
+			return
+		}
+
+		connection, err := c.dial()
+		if err != nil {
+			c.logger.WithError(err).Error("reconnect to rabbitmq failed, retrying...")
+			cause = err
+			continue
+		}
+
+		c.connected(connection)
+		return
+	}
+}
+
+// setState has to be called with lock held
+func (c *Client) setState(state protocols.ConnectionState, attempt uint, err error) {
+	c.state = state
+	publishConnectionStatus(c.statusCh, newConnectionStatus(c.oddsFeedConfiguration.Clock().Now(), state, attempt, err))
+}
+
+type amqpChannel struct {
+	channel      *amqp.Channel
+	queueName    string
+	exchangeName string
+	deliveries   chan protocols.TransportDelivery
+	closeCh      chan struct{}
+	closeOnce    sync.Once
+}
+
+func (a *amqpChannel) Deliveries() <-chan protocols.TransportDelivery {
+	return a.deliveries
+}
+
+func (a *amqpChannel) Bind(routingKey string) error {
+	return a.channel.QueueBind(a.queueName, routingKey, a.exchangeName, false, nil)
+}
+
+func (a *amqpChannel) Unbind(routingKey string) error {
+	return a.channel.QueueUnbind(a.queueName, routingKey, a.exchangeName, nil)
+}
+
+func (a *amqpChannel) Close() error {
+	a.closeOnce.Do(func() {
+		close(a.closeCh)
+	})
+
+	return a.channel.Close()
+}
+
+func newAMQPChannel(
+	channel *amqp.Channel,
+	queueName string,
+	exchangeName string,
+	deliveries <-chan amqp.Delivery,
+	manualAck bool,
+) *amqpChannel {
+	result := &amqpChannel{
+		channel:      channel,
+		queueName:    queueName,
+		exchangeName: exchangeName,
+		deliveries:   make(chan protocols.TransportDelivery),
+		closeCh:      make(chan struct{}),
+	}
+
+	go func() {
+		defer close(result.deliveries)
+
+		for msg := range deliveries {
+			delivery := protocols.TransportDelivery{
+				RoutingKey: msg.RoutingKey,
+				Body:       msg.Body,
+				Timestamp:  msg.Timestamp,
 			}
 
-			go c.reconnect()
+			if manualAck {
+				delivery.Acknowledger = amqpAcknowledger{delivery: msg}
+			}
+
+			select {
+			case result.deliveries <- delivery:
+			case <-result.closeCh:
+				return
+			}
 		}
 	}()
-	c.connection.NotifyClose(errorCh)
 
-	return err
+	return result
 }
 
-func (c *Client) reconnect() {
-	err := c.Open()
-	if err != nil {
-		c.logger.WithError(err).Error("reconnect to rabbitmq failed, retrying...")
-		time.Sleep(5 * time.Second)
-		go c.reconnect()
-	}
+type amqpAcknowledger struct {
+	delivery amqp.Delivery
+}
+
+func (a amqpAcknowledger) Ack() error {
+	return a.delivery.Ack(false)
+}
+
+func (a amqpAcknowledger) Nack(requeue bool) error {
+	return a.delivery.Nack(false, requeue)
+}
+
+func (a amqpAcknowledger) Redelivered() bool {
+	return a.delivery.Redelivered
 }
@@ -0,0 +1,78 @@
+package feed
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/oddin-gg/gosdk/protocols"
+)
+
+const (
+	connectionStatusBuffer = 16
+	minReconnectDelay      = 1 * time.Second
+	maxReconnectDelay      = 60 * time.Second
+)
+
+// reconnectDelay grows exponentially with attempt and is randomized to avoid reconnect storms
+func reconnectDelay(attempt uint) time.Duration {
+	delay := maxReconnectDelay
+	if attempt > 0 && attempt < 7 {
+		delay = minReconnectDelay << (attempt - 1)
+	}
+
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// publishConnectionStatus drops the oldest buffered status when ch is full, so the latest state is always delivered,
+// it has to be called by single publisher at a time
+func publishConnectionStatus(ch chan protocols.ConnectionStatus, status protocols.ConnectionStatus) {
+	for {
+		select {
+		case ch <- status:
+			return
+		default:
+		}
+
+		select {
+		case <-ch:
+		default:
+		}
+	}
+}
+
+type connectionStatusImpl struct {
+	state     protocols.ConnectionState
+	timestamp time.Time
+	attempt   uint
+	err       error
+}
+
+func (c connectionStatusImpl) IsDown() bool {
+	return c.state != protocols.ConnectedConnectionState
+}
+
+func (c connectionStatusImpl) State() protocols.ConnectionState {
+	return c.state
+}
+
+func (c connectionStatusImpl) Timestamp() time.Time {
+	return c.timestamp
+}
+
+func (c connectionStatusImpl) Attempt() uint {
+	return c.attempt
+}
+
+func (c connectionStatusImpl) Err() error {
+	return c.err
+}
+
+func newConnectionStatus(timestamp time.Time, state protocols.ConnectionState, attempt uint, err error) protocols.ConnectionStatus {
+	return connectionStatusImpl{
+		state:     state,
+		timestamp: timestamp,
+		attempt:   attempt,
+		err:       err,
+	}
+}
@@ -0,0 +1,258 @@
+package feed
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/oddin-gg/gosdk/protocols"
+)
+
+const memoryChannelBuffer = 128
+
+// MemoryTransport ...
+type MemoryTransport struct {
+	lock      sync.RWMutex
+	channels  map[*memoryChannel]struct{}
+	opened    bool
+	statusCh  chan protocols.ConnectionStatus
+	closeCh   chan struct{}
+	closeOnce sync.Once
+	clock     protocols.Clock
+}
+
+// Open ...
+func (m *MemoryTransport) Open() error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.isClosed() {
+		return errors.New("transport is closed")
+	}
+
+	m.opened = true
+	m.notify(protocols.ConnectedConnectionState)
+	return nil
+}
+
+// CreateChannel ignores queue options - deliveries are never persisted nor redelivered
+func (m *MemoryTransport) CreateChannel(exchangeName string, routingKeys []string, _ protocols.ChannelOptions) (protocols.TransportChannel, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	switch {
+	case m.isClosed():
+		return nil, errors.New("transport is closed")
+	case !m.opened:
+		return nil, errors.New("connection is not opened")
+	}
+
+	channel := &memoryChannel{
+		transport:    m,
+		exchangeName: exchangeName,
+		routingKeys:  append([]string(nil), routingKeys...),
+		deliveries:   make(chan protocols.TransportDelivery, memoryChannelBuffer),
+		closeCh:      make(chan struct{}),
+	}
+	m.channels[channel] = struct{}{}
+
+	return channel, nil
+}
+
+// Publish delivers message to every channel bound to the exchange with a matching routing key, slow consumer
+// blocks only the publisher
+func (m *MemoryTransport) Publish(exchangeName string, delivery protocols.TransportDelivery) error {
+	m.lock.RLock()
+	if m.isClosed() {
+		m.lock.RUnlock()
+		return errors.New("transport is closed")
+	}
+
+	channels := make([]*memoryChannel, 0, len(m.channels))
+	for channel := range m.channels {
+		if channel.matches(exchangeName, delivery.RoutingKey) {
+			channels = append(channels, channel)
+		}
+	}
+	m.lock.RUnlock()
+
+	for _, channel := range channels {
+		err := channel.send(delivery, m.closeCh)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ConnectionStatusCh ...
+func (m *MemoryTransport) ConnectionStatusCh() <-chan protocols.ConnectionStatus {
+	return m.statusCh
+}
+
+// Close ...
+func (m *MemoryTransport) Close() {
+	m.closeOnce.Do(func() {
+		close(m.closeCh)
+	})
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.opened {
+		m.opened = false
+		m.notify(protocols.ClosedConnectionState)
+	}
+
+	for channel := range m.channels {
+		channel.closeDeliveries()
+	}
+	m.channels = make(map[*memoryChannel]struct{})
+}
+
+func (m *MemoryTransport) isClosed() bool {
+	select {
+	case <-m.closeCh:
+		return true
+	default:
+		return false
+	}
+}
+
+// notify has to be called with lock held
+func (m *MemoryTransport) notify(state protocols.ConnectionState) {
+	publishConnectionStatus(m.statusCh, newConnectionStatus(m.clock.Now(), state, 0, nil))
+}
+
+func (m *MemoryTransport) removeChannel(channel *memoryChannel) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.channels[channel]; !ok {
+		return
+	}
+
+	delete(m.channels, channel)
+	channel.closeDeliveries()
+}
+
+type memoryChannel struct {
+	transport    *MemoryTransport
+	exchangeName string
+	routingKeys  []string
+	deliveries   chan protocols.TransportDelivery
+	closeCh      chan struct{}
+	closeOnce    sync.Once
+	// sendLock is held by publishers, deliveries are closed under it after closeCh
+	sendLock sync.RWMutex
+}
+
+func (m *memoryChannel) send(delivery protocols.TransportDelivery, transportCloseCh <-chan struct{}) error {
+	m.sendLock.RLock()
+	defer m.sendLock.RUnlock()
+
+	select {
+	case <-m.closeCh:
+		return nil
+	default:
+	}
+
+	select {
+	case m.deliveries <- delivery:
+	case <-m.closeCh:
+	case <-transportCloseCh:
+		return errors.New("transport is closed")
+	}
+
+	return nil
+}
+
+// closeDeliveries has to be called once, with transport lock held
+func (m *memoryChannel) closeDeliveries() {
+	m.closeOnce.Do(func() {
+		close(m.closeCh)
+	})
+
+	m.sendLock.Lock()
+	defer m.sendLock.Unlock()
+
+	close(m.deliveries)
+}
+
+func (m *memoryChannel) Deliveries() <-chan protocols.TransportDelivery {
+	return m.deliveries
+}
+
+func (m *memoryChannel) Bind(routingKey string) error {
+	m.transport.lock.Lock()
+	defer m.transport.lock.Unlock()
+
+	if !slices.Contains(m.routingKeys, routingKey) {
+		m.routingKeys = append(m.routingKeys, routingKey)
+	}
+
+	return nil
+}
+
+func (m *memoryChannel) Unbind(routingKey string) error {
+	m.transport.lock.Lock()
+	defer m.transport.lock.Unlock()
+
+	m.routingKeys = slices.DeleteFunc(m.routingKeys, func(key string) bool {
+		return key == routingKey
+	})
+
+	return nil
+}
+
+func (m *memoryChannel) Close() error {
+	m.transport.removeChannel(m)
+	return nil
+}
+
+func (m *memoryChannel) matches(exchangeName string, routingKey string) bool {
+	if m.exchangeName != exchangeName {
+		return false
+	}
+
+	for _, pattern := range m.routingKeys {
+		if matchRoutingKey(strings.Split(pattern, "."), strings.Split(routingKey, ".")) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchRoutingKey follows AMQP topic exchange rules - * matches exactly one word, # zero or more words
+func matchRoutingKey(pattern []string, key []string) bool {
+	switch {
+	case len(pattern) == 0:
+		return len(key) == 0
+	case pattern[0] == "#":
+		for i := 0; i <= len(key); i++ {
+			if matchRoutingKey(pattern[1:], key[i:]) {
+				return true
+			}
+		}
+		return false
+	case len(key) == 0:
+		return false
+	case pattern[0] == "*" || pattern[0] == key[0]:
+		return matchRoutingKey(pattern[1:], key[1:])
+	default:
+		return false
+	}
+}
+
+// NewMemoryTransport creates transport which stamps connection statuses by time of the clock
+func NewMemoryTransport(clock protocols.Clock) *MemoryTransport {
+	return &MemoryTransport{
+		channels: make(map[*memoryChannel]struct{}),
+		statusCh: make(chan protocols.ConnectionStatus, connectionStatusBuffer),
+		closeCh:  make(chan struct{}),
+		clock:    clock,
+	}
+}
@@ -0,0 +1,181 @@
+package feed
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/oddin-gg/gosdk/internal/utils"
+	"github.com/oddin-gg/gosdk/protocols"
+)
+
+func TestMatchRoutingKey(t *testing.T) {
+	tests := []struct {
+		pattern string
+		key     string
+		match   bool
+	}{
+		{pattern: "hi.pre.-.odds_change.1.od:match.1", key: "hi.pre.-.odds_change.1.od:match.1", match: true},
+		{pattern: "hi.pre.-.odds_change.1.od:match.1", key: "hi.pre.-.odds_change.1.od:match.2", match: false},
+		{pattern: "*.*.*.odds_change.#", key: "hi.pre.-.odds_change.1.od:match.1", match: true},
+		{pattern: "*.*.*.odds_change.#", key: "hi.pre.-.odds_change", match: true},
+		{pattern: "*.*.*.odds_change.#", key: "hi.pre.-.bet_stop.1.od:match.1", match: false},
+		{pattern: "*.*.*.odds_change", key: "hi.pre.-.odds_change.1", match: false},
+		{pattern: "*", key: "a.b", match: false},
+		{pattern: "#", key: "a.b.c", match: true},
+		{pattern: "#.c", key: "c", match: true},
+		{pattern: "#.c", key: "a.b.c", match: true},
+		{pattern: "#.c", key: "a.b.d", match: false},
+		{pattern: "a.#.c", key: "a.c", match: true},
+		{pattern: "a.#.c", key: "a.x.y.c", match: true},
+		{pattern: "a.*.c", key: "a.c", match: false},
+		{pattern: "-.-.-.alive.#", key: "-.-.-.alive.-.-.-.-", match: true},
+	}
+
+	for _, test := range tests {
+		match := matchRoutingKey(strings.Split(test.pattern, "."), strings.Split(test.key, "."))
+		if match != test.match {
+			t.Errorf("pattern %q and key %q: expected %t, got %t", test.pattern, test.key, test.match, match)
+		}
+	}
+}
+
+func TestMemoryTransportPublish(t *testing.T) {
+	transport := NewMemoryTransport(utils.SystemClock{})
+	if _, err := transport.CreateChannel("oddinfeed", nil, protocols.ChannelOptions{}); err == nil {
+		t.Fatal("channel created before open")
+	}
+
+	if err := transport.Open(); err != nil {
+		t.Fatal(err)
+	}
+
+	oddsChannel, err := transport.CreateChannel("oddinfeed", []string{"*.*.*.odds_change.#"}, protocols.ChannelOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	aliveChannel, err := transport.CreateChannel("oddinfeed", []string{"-.-.-.alive.#"}, protocols.ChannelOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	publish := func(exchangeName string, routingKey string) {
+		t.Helper()
+		err := transport.Publish(exchangeName, protocols.TransportDelivery{RoutingKey: routingKey, Body: []byte(routingKey)})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	publish("oddinfeed", "hi.pre.-.odds_change.1.od:match.1")
+	publish("oddinfeed", "-.-.-.alive.-.-.-.-")
+	publish("other", "hi.pre.-.odds_change.1.od:match.2")
+
+	expectDelivery(t, oddsChannel, "hi.pre.-.odds_change.1.od:match.1")
+	expectDelivery(t, aliveChannel, "-.-.-.alive.-.-.-.-")
+	expectNoDelivery(t, oddsChannel)
+	expectNoDelivery(t, aliveChannel)
+
+	if err := oddsChannel.Bind("hi.pre.-.bet_stop.1.od:match.1"); err != nil {
+		t.Fatal(err)
+	}
+	publish("oddinfeed", "hi.pre.-.bet_stop.1.od:match.1")
+	expectDelivery(t, oddsChannel, "hi.pre.-.bet_stop.1.od:match.1")
+
+	if err := oddsChannel.Unbind("*.*.*.odds_change.#"); err != nil {
+		t.Fatal(err)
+	}
+	publish("oddinfeed", "hi.pre.-.odds_change.1.od:match.1")
+	expectNoDelivery(t, oddsChannel)
+
+	if err := oddsChannel.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-oddsChannel.Deliveries(); ok {
+		t.Fatal("deliveries of closed channel are not closed")
+	}
+
+	transport.Close()
+	if _, ok := <-aliveChannel.Deliveries(); ok {
+		t.Fatal("deliveries are not closed with transport")
+	}
+
+	if err := transport.Publish("oddinfeed", protocols.TransportDelivery{RoutingKey: "-.-.-.alive.-.-.-.-"}); err == nil {
+		t.Fatal("published to closed transport")
+	}
+}
+
+func TestMemoryTransportSlowConsumer(t *testing.T) {
+	transport := NewMemoryTransport(utils.SystemClock{})
+	if err := transport.Open(); err != nil {
+		t.Fatal(err)
+	}
+
+	slowChannel, err := transport.CreateChannel("oddinfeed", []string{"#"}, protocols.ChannelOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	otherChannel, err := transport.CreateChannel("oddinfeed", nil, protocols.ChannelOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	published := make(chan error)
+	go func() {
+		for i := 0; i <= memoryChannelBuffer; i++ {
+			err := transport.Publish("oddinfeed", protocols.TransportDelivery{RoutingKey: "-.-.-.alive.-.-.-.-"})
+			if err != nil {
+				published <- err
+				return
+			}
+		}
+		published <- nil
+	}()
+
+	// Publisher is blocked by the full channel, other channels and the transport have to stay usable
+	time.Sleep(50 * time.Millisecond)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = otherChannel.Bind("-.-.-.alive.#")
+		_ = slowChannel.Close()
+		transport.Close()
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("slow consumer blocked the transport")
+	}
+
+	select {
+	case <-published:
+	case <-time.After(time.Second):
+		t.Fatal("publisher is still blocked after close")
+	}
+}
+
+func expectDelivery(t *testing.T, channel protocols.TransportChannel, routingKey string) {
+	t.Helper()
+
+	select {
+	case delivery := <-channel.Deliveries():
+		if delivery.RoutingKey != routingKey {
+			t.Fatalf("expected delivery %s, got %s", routingKey, delivery.RoutingKey)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("delivery %s not received", routingKey)
+	}
+}
+
+func expectNoDelivery(t *testing.T, channel protocols.TransportChannel) {
+	t.Helper()
+
+	select {
+	case delivery := <-channel.Deliveries():
+		t.Fatalf("unexpected delivery %s", delivery.RoutingKey)
+	default:
+	}
+}
@@ -0,0 +1,325 @@
+package feed
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/oddin-gg/gosdk/protocols"
+	log "github.com/sirupsen/logrus"
+)
+
+const oddsChangeMessageType = "odds_change"
+
+// deliveryQueue decouples transport from session processing, overflow is handled by configured policy.
+// Spilled messages are acknowledged only after the session processed them, so the broker redelivers them
+// when the queue is closed or the spill file can not be read.
+type deliveryQueue struct {
+	// spillLock guards spill file and is always acquired before lock, file is never accessed while holding lock
+	spillLock sync.Mutex
+	lock      sync.Mutex
+	cond      *sync.Cond
+	params    protocols.OverflowParams
+	items     []protocols.TransportDelivery
+	spill     *spillFile
+	// spilled holds acknowledgers of the records in spill file in the same order
+	spilled spilledAcks
+	writing bool
+	logger  *log.Entry
+	closed  bool
+}
+
+func (q *deliveryQueue) push(delivery protocols.TransportDelivery) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	for !q.closed {
+		switch {
+		// Once spilling started, new messages have to go to disk as well to keep the order
+		case q.spilled.len() == 0 && !q.writing && len(q.items) < q.params.Capacity:
+			q.items = append(q.items, delivery)
+			q.cond.Broadcast()
+			return
+
+		case q.params.Policy == protocols.SpillToDiskOverflowPolicy:
+			q.writing = true
+			q.lock.Unlock()
+			err := q.writeSpill(delivery)
+			q.lock.Lock()
+			q.writing = false
+
+			switch {
+			case err != nil:
+				if !q.closed {
+					q.logger.WithError(err).Error("failed to spill message to disk, waiting for free space")
+					q.cond.Wait()
+				}
+				continue
+			case q.closed:
+				// Message was returned to broker either by writeSpill or by close
+				return
+			}
+
+			q.params.Stats.RecordSpilled()
+			q.cond.Broadcast()
+			return
+
+		case q.params.Policy == protocols.DropOldestOddsChangeOverflowPolicy && q.dropOldestOddsChange(delivery):
+			q.items = append(q.items, delivery)
+			q.cond.Broadcast()
+			return
+
+		default:
+			q.cond.Wait()
+		}
+	}
+
+	// Queue is closed, broker redelivers the message
+	q.nack(delivery.Acknowledger)
+}
+
+func (q *deliveryQueue) pop() (protocols.TransportDelivery, bool) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	for !q.closed {
+		switch {
+		case len(q.items) != 0:
+			delivery := q.items[0]
+			q.items[0] = protocols.TransportDelivery{}
+			q.items = q.items[1:]
+			q.cond.Broadcast()
+			return delivery, true
+
+		case q.spilled.len() != 0:
+			q.lock.Unlock()
+			delivery, ok := q.readSpill()
+			q.lock.Lock()
+
+			if !ok {
+				continue
+			}
+
+			if q.closed {
+				q.nack(delivery.Acknowledger)
+				return protocols.TransportDelivery{}, false
+			}
+
+			q.cond.Broadcast()
+			return delivery, true
+
+		default:
+			q.cond.Wait()
+		}
+	}
+
+	return protocols.TransportDelivery{}, false
+}
+
+// close returns all unprocessed messages to the broker
+func (q *deliveryQueue) close() {
+	q.spillLock.Lock()
+	defer q.spillLock.Unlock()
+
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if q.closed {
+		return
+	}
+
+	q.closed = true
+	for _, delivery := range q.items {
+		q.nack(delivery.Acknowledger)
+	}
+	q.items = nil
+
+	if q.spilled.len() != 0 {
+		q.logger.Warnf("closing queue with %d spilled messages, returning them to broker", q.spilled.len())
+		q.returnSpilled()
+	}
+
+	q.cond.Broadcast()
+}
+
+// writeSpill persists delivery and keeps its acknowledger until the message is read back
+func (q *deliveryQueue) writeSpill(delivery protocols.TransportDelivery) error {
+	q.spillLock.Lock()
+	defer q.spillLock.Unlock()
+
+	// Closing needs spillLock, so the queue can not be closed until the acknowledger is stored
+	q.lock.Lock()
+	closed := q.closed
+	q.lock.Unlock()
+
+	if closed {
+		q.nack(delivery.Acknowledger)
+		return nil
+	}
+
+	err := q.spill.write(delivery)
+	if err != nil {
+		return err
+	}
+
+	q.lock.Lock()
+	q.spilled.push(delivery.Acknowledger)
+	q.lock.Unlock()
+
+	return nil
+}
+
+// readSpill returns the oldest spilled delivery with its original acknowledger
+func (q *deliveryQueue) readSpill() (protocols.TransportDelivery, bool) {
+	q.spillLock.Lock()
+	defer q.spillLock.Unlock()
+
+	q.lock.Lock()
+	pending := q.spilled.len()
+	q.lock.Unlock()
+
+	if pending == 0 {
+		// Queue was closed in the meantime
+		return protocols.TransportDelivery{}, false
+	}
+
+	delivery, err := q.spill.read()
+
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if err != nil {
+		q.logger.WithError(err).Errorf("failed to read spilled messages, returning %d messages to broker", q.spilled.len())
+		q.returnSpilled()
+		return protocols.TransportDelivery{}, false
+	}
+
+	delivery.Acknowledger = q.spilled.pop()
+	return delivery, true
+}
+
+// returnSpilled nacks all spilled messages and removes the spill file, it has to be called with both locks held.
+// Messages consumed without manual acknowledgement can not be redelivered and are counted as dropped.
+func (q *deliveryQueue) returnSpilled() {
+	for _, entry := range q.spilled.entries {
+		if entry.acknowledger == nil {
+			for i := 0; i < entry.count; i++ {
+				q.params.Stats.RecordDropped()
+			}
+			continue
+		}
+
+		q.nack(entry.acknowledger)
+	}
+
+	q.spilled = spilledAcks{}
+	q.spill.discard()
+}
+
+// dropOldestOddsChange removes the oldest queued odds change of the same event as delivery
+func (q *deliveryQueue) dropOldestOddsChange(delivery protocols.TransportDelivery) bool {
+	event, ok := oddsChangeEvent(delivery.RoutingKey)
+	if !ok {
+		return false
+	}
+
+	for i := range q.items {
+		queuedEvent, ok := oddsChangeEvent(q.items[i].RoutingKey)
+		if !ok || queuedEvent != event {
+			continue
+		}
+
+		q.ack(q.items[i])
+		q.items = append(q.items[:i], q.items[i+1:]...)
+		q.params.Stats.RecordDropped()
+		return true
+	}
+
+	return false
+}
+
+func (q *deliveryQueue) ack(delivery protocols.TransportDelivery) {
+	if delivery.Acknowledger == nil {
+		return
+	}
+
+	err := delivery.Acknowledger.Ack()
+	if err != nil {
+		q.logger.WithError(err).Errorf("failed to ack message from %s", delivery.RoutingKey)
+	}
+}
+
+func (q *deliveryQueue) nack(acknowledger protocols.Acknowledger) {
+	if acknowledger == nil {
+		return
+	}
+
+	err := acknowledger.Nack(true)
+	if err != nil {
+		q.logger.WithError(err).Error("failed to nack queued message")
+	}
+}
+
+// spilledAcks is a FIFO of acknowledgers of spilled records. Consecutive records without acknowledger share one
+// entry, so only manually acknowledged records, which are limited by the prefetch count, take memory each.
+type spilledAcks struct {
+	entries []spilledAck
+	count   int
+}
+
+type spilledAck struct {
+	acknowledger protocols.Acknowledger
+	count        int
+}
+
+func (s *spilledAcks) push(acknowledger protocols.Acknowledger) {
+	s.count++
+
+	last := len(s.entries) - 1
+	if acknowledger == nil && last >= 0 && s.entries[last].acknowledger == nil {
+		s.entries[last].count++
+		return
+	}
+
+	s.entries = append(s.entries, spilledAck{
+		acknowledger: acknowledger,
+		count:        1,
+	})
+}
+
+func (s *spilledAcks) pop() protocols.Acknowledger {
+	s.count--
+
+	acknowledger := s.entries[0].acknowledger
+	s.entries[0].count--
+	if s.entries[0].count == 0 {
+		s.entries[0] = spilledAck{}
+		s.entries = s.entries[1:]
+	}
+
+	return acknowledger
+}
+
+func (s *spilledAcks) len() int {
+	return s.count
+}
+
+func oddsChangeEvent(routingKey string) (string, bool) {
+	parts := strings.Split(routingKey, ".")
+	if len(parts) != 8 || parts[3] != oddsChangeMessageType || parts[6] == emptyPosition {
+		return "", false
+	}
+
+	return parts[5] + ":" + parts[6], true
+}
+
+func newDeliveryQueue(params protocols.OverflowParams, logger *log.Entry) *deliveryQueue {
+	queue := &deliveryQueue{
+		params: params,
+		items:  make([]protocols.TransportDelivery, 0, params.Capacity),
+		spill:  &spillFile{directory: params.SpillDirectory},
+		logger: logger,
+	}
+	queue.cond = sync.NewCond(&queue.lock)
+
+	return queue
+}
@@ -0,0 +1,237 @@
+package feed
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oddin-gg/gosdk/protocols"
+	log "github.com/sirupsen/logrus"
+)
+
+type testAcknowledger struct {
+	lock    sync.Mutex
+	acked   bool
+	nacked  bool
+	requeue bool
+}
+
+func (t *testAcknowledger) Ack() error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.acked = true
+	return nil
+}
+
+func (t *testAcknowledger) Nack(requeue bool) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.nacked = true
+	t.requeue = requeue
+	return nil
+}
+
+func (t *testAcknowledger) state() (bool, bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	return t.acked, t.nacked
+}
+
+func oddsChangeDelivery(eventID int, acknowledger protocols.Acknowledger) protocols.TransportDelivery {
+	return protocols.TransportDelivery{
+		RoutingKey:   fmt.Sprintf("hi.-.live.odds_change.1.od:match.%d.-", eventID),
+		Body:         []byte(fmt.Sprintf("odds change %d", eventID)),
+		Acknowledger: acknowledger,
+	}
+}
+
+func betSettlementDelivery(eventID int, acknowledger protocols.Acknowledger) protocols.TransportDelivery {
+	return protocols.TransportDelivery{
+		RoutingKey:   fmt.Sprintf("hi.-.live.bet_settlement.1.od:match.%d.-", eventID),
+		Body:         []byte(fmt.Sprintf("bet settlement %d", eventID)),
+		Acknowledger: acknowledger,
+	}
+}
+
+func newTestQueue(t *testing.T, policy protocols.OverflowPolicy, capacity int) (*deliveryQueue, *protocols.OverflowStats) {
+	stats := &protocols.OverflowStats{}
+	queue := newDeliveryQueue(protocols.OverflowParams{
+		Policy:         policy,
+		Capacity:       capacity,
+		SpillDirectory: t.TempDir(),
+		Stats:          stats,
+	}, log.NewEntry(log.New()))
+	t.Cleanup(queue.close)
+
+	return queue, stats
+}
+
+func popDelivery(t *testing.T, queue *deliveryQueue) protocols.TransportDelivery {
+	t.Helper()
+
+	delivery, ok := queue.pop()
+	if !ok {
+		t.Fatal("queue is closed")
+	}
+
+	return delivery
+}
+
+func expectBody(t *testing.T, delivery protocols.TransportDelivery, body string) {
+	t.Helper()
+
+	if string(delivery.Body) != body {
+		t.Fatalf("expected %q, got %q", body, delivery.Body)
+	}
+}
+
+// pushBlocked pushes delivery in background and verifies it waits until the queue has free space
+func pushBlocked(t *testing.T, queue *deliveryQueue, delivery protocols.TransportDelivery) <-chan struct{} {
+	t.Helper()
+
+	pushed := make(chan struct{})
+	go func() {
+		defer close(pushed)
+		queue.push(delivery)
+	}()
+
+	select {
+	case <-pushed:
+		t.Fatal("push did not block on full queue")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	return pushed
+}
+
+func TestDeliveryQueueBlock(t *testing.T) {
+	queue, stats := newTestQueue(t, protocols.BlockOverflowPolicy, 2)
+
+	queue.push(oddsChangeDelivery(1, nil))
+	queue.push(oddsChangeDelivery(1, nil))
+	pushed := pushBlocked(t, queue, betSettlementDelivery(1, nil))
+
+	expectBody(t, popDelivery(t, queue), "odds change 1")
+	<-pushed
+	expectBody(t, popDelivery(t, queue), "odds change 1")
+	expectBody(t, popDelivery(t, queue), "bet settlement 1")
+
+	if stats.Dropped() != 0 || stats.Spilled() != 0 {
+		t.Fatalf("unexpected stats - dropped %d, spilled %d", stats.Dropped(), stats.Spilled())
+	}
+}
+
+func TestDeliveryQueueDropOldestOddsChange(t *testing.T) {
+	queue, stats := newTestQueue(t, protocols.DropOldestOddsChangeOverflowPolicy, 2)
+
+	dropped := &testAcknowledger{}
+	kept := &testAcknowledger{}
+	queue.push(oddsChangeDelivery(1, dropped))
+	queue.push(oddsChangeDelivery(2, kept))
+	queue.push(oddsChangeDelivery(1, nil))
+
+	if acked, _ := dropped.state(); !acked {
+		t.Fatal("dropped odds change was not acknowledged")
+	}
+	if acked, nacked := kept.state(); acked || nacked {
+		t.Fatal("queued odds change was acknowledged before processing")
+	}
+	if stats.Dropped() != 1 {
+		t.Fatalf("expected 1 dropped message, got %d", stats.Dropped())
+	}
+
+	// Settlements are never dropped
+	pushed := pushBlocked(t, queue, betSettlementDelivery(2, nil))
+
+	expectBody(t, popDelivery(t, queue), "odds change 2")
+	<-pushed
+	expectBody(t, popDelivery(t, queue), "odds change 1")
+	expectBody(t, popDelivery(t, queue), "bet settlement 2")
+}
+
+func TestDeliveryQueueSpillToDisk(t *testing.T) {
+	queue, stats := newTestQueue(t, protocols.SpillToDiskOverflowPolicy, 2)
+
+	acknowledgers := make([]*testAcknowledger, 6)
+	for i := range acknowledgers {
+		acknowledgers[i] = &testAcknowledger{}
+		queue.push(oddsChangeDelivery(i, acknowledgers[i]))
+	}
+
+	if stats.Spilled() != 4 {
+		t.Fatalf("expected 4 spilled messages, got %d", stats.Spilled())
+	}
+
+	for i := range acknowledgers {
+		delivery := popDelivery(t, queue)
+		expectBody(t, delivery, fmt.Sprintf("odds change %d", i))
+
+		if acked, nacked := acknowledgers[i].state(); acked || nacked {
+			t.Fatalf("message %d was acknowledged before processing", i)
+		}
+
+		if delivery.Acknowledger != acknowledgers[i] {
+			t.Fatalf("message %d is delivered with wrong acknowledger", i)
+		}
+
+		_ = delivery.Acknowledger.Ack()
+	}
+}
+
+func TestDeliveryQueueSpillToDiskConcurrent(t *testing.T) {
+	queue, _ := newTestQueue(t, protocols.SpillToDiskOverflowPolicy, 4)
+
+	const count = 500
+	go func() {
+		for i := 0; i < count; i++ {
+			queue.push(oddsChangeDelivery(i, nil))
+		}
+	}()
+
+	for i := 0; i < count; i++ {
+		expectBody(t, popDelivery(t, queue), fmt.Sprintf("odds change %d", i))
+	}
+}
+
+func TestDeliveryQueueCloseReturnsMessages(t *testing.T) {
+	queue, stats := newTestQueue(t, protocols.SpillToDiskOverflowPolicy, 1)
+
+	queued := &testAcknowledger{}
+	spilled := &testAcknowledger{}
+	queue.push(oddsChangeDelivery(1, queued))
+	queue.push(oddsChangeDelivery(2, spilled))
+	queue.push(oddsChangeDelivery(3, nil))
+	queue.push(oddsChangeDelivery(4, nil))
+
+	if len(queue.spilled.entries) != 2 {
+		t.Fatalf("expected 2 spilled acknowledger entries, got %d", len(queue.spilled.entries))
+	}
+
+	queue.close()
+
+	for i, acknowledger := range []*testAcknowledger{queued, spilled} {
+		_, nacked := acknowledger.state()
+		if !nacked || !acknowledger.requeue {
+			t.Fatalf("message %d was not returned to broker", i+1)
+		}
+	}
+
+	// Automatically acknowledged messages can not be returned
+	if stats.Dropped() != 2 {
+		t.Fatalf("expected 2 dropped messages, got %d", stats.Dropped())
+	}
+
+	if _, ok := queue.pop(); ok {
+		t.Fatal("closed queue returned message")
+	}
+
+	late := &testAcknowledger{}
+	queue.push(oddsChangeDelivery(5, late))
+	if _, nacked := late.state(); !nacked {
+		t.Fatal("message pushed to closed queue was not returned to broker")
+	}
+}
@@ -0,0 +1,110 @@
+package feed
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/oddin-gg/gosdk/protocols"
+)
+
+type spilledDelivery struct {
+	RoutingKey string    `json:"routing_key"`
+	Timestamp  time.Time `json:"timestamp"`
+	Body       []byte    `json:"body"`
+}
+
+// spillFile is a disk backed FIFO of deliveries, records are length prefixed JSON documents.
+// Acknowledgers are not persisted, deliveryQueue keeps them in memory.
+type spillFile struct {
+	directory   string
+	file        *os.File
+	readOffset  int64
+	writeOffset int64
+	count       int
+}
+
+func (s *spillFile) write(delivery protocols.TransportDelivery) error {
+	if s.file == nil {
+		file, err := os.CreateTemp(s.directory, "gosdk-spill-*")
+		if err != nil {
+			return err
+		}
+		s.file = file
+	}
+
+	data, err := json.Marshal(spilledDelivery{
+		RoutingKey: delivery.RoutingKey,
+		Timestamp:  delivery.Timestamp,
+		Body:       delivery.Body,
+	})
+	if err != nil {
+		return err
+	}
+
+	record := make([]byte, 4+len(data))
+	binary.BigEndian.PutUint32(record, uint32(len(data)))
+	copy(record[4:], data)
+
+	_, err = s.file.WriteAt(record, s.writeOffset)
+	if err != nil {
+		return err
+	}
+
+	s.writeOffset += int64(len(record))
+	s.count++
+	return nil
+}
+
+func (s *spillFile) read() (protocols.TransportDelivery, error) {
+	header := make([]byte, 4)
+	_, err := s.file.ReadAt(header, s.readOffset)
+	if err != nil {
+		return protocols.TransportDelivery{}, err
+	}
+
+	data := make([]byte, binary.BigEndian.Uint32(header))
+	_, err = s.file.ReadAt(data, s.readOffset+4)
+	if err != nil {
+		return protocols.TransportDelivery{}, err
+	}
+
+	s.readOffset += int64(4 + len(data))
+	s.count--
+
+	if s.count == 0 {
+		// Everything was read, start from the beginning to keep the file small
+		s.readOffset = 0
+		s.writeOffset = 0
+		err = s.file.Truncate(0)
+		if err != nil {
+			return protocols.TransportDelivery{}, err
+		}
+	}
+
+	var spilled spilledDelivery
+	err = json.Unmarshal(data, &spilled)
+	if err != nil {
+		return protocols.TransportDelivery{}, err
+	}
+
+	return protocols.TransportDelivery{
+		RoutingKey: spilled.RoutingKey,
+		Body:       spilled.Body,
+		Timestamp:  spilled.Timestamp,
+	}, nil
+}
+
+// discard removes the file with all pending records
+func (s *spillFile) discard() {
+	s.count = 0
+	s.readOffset = 0
+	s.writeOffset = 0
+
+	if s.file != nil {
+		_ = s.file.Close()
+		_ = os.Remove(s.file.Name())
+		s.file = nil
+	}
+}
@@ -9,8 +9,8 @@ import (
 type BetStop struct {
 	XMLName xml.Name `xml:"bet_stop"`
 	MessageAttributes
-	Groups string       `xml:"groups,attr,omitempty"`
-	Status MarketStatus `xml:"market_status,attr,omitempty"`
+	Groups string        `xml:"groups,attr,omitempty"`
+	Status *MarketStatus `xml:"market_status,attr,omitempty"`
 }
 
 // Product ...
@@ -0,0 +1,158 @@
+package journal_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/oddin-gg/gosdk/internal/feed"
+	"github.com/oddin-gg/gosdk/internal/journal"
+	"github.com/oddin-gg/gosdk/internal/utils"
+	"github.com/oddin-gg/gosdk/protocols"
+)
+
+const exchangeName = "oddinfeed"
+
+func writeRecords(t *testing.T, params protocols.JournalParams, records []journal.Record) {
+	t.Helper()
+
+	writer := journal.NewWriter(params, utils.SystemClock{})
+	for _, record := range records {
+		if err := writer.Write(record); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := writer.Write(records[0]); err == nil {
+		t.Fatal("record written into closed journal")
+	}
+}
+
+func testRecords(count int) []journal.Record {
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	records := make([]journal.Record, 0, count)
+	for i := 0; i < count; i++ {
+		records = append(records, journal.Record{
+			RoutingKey:      fmt.Sprintf("hi.-.live.odds_change.1.od:match.%d.-", i),
+			Timestamp:       now.Add(time.Duration(i) * time.Second),
+			ReceivedAt:      now.Add(time.Duration(i) * time.Second),
+			Body:            []byte(fmt.Sprintf(`<odds_change event_id="od:match:%d"/>`, i)),
+			MessageInterest: protocols.LiveOnlyMessageInterest.Name(),
+			Session:         1,
+		})
+	}
+
+	return records
+}
+
+func TestJournalRoundTrip(t *testing.T) {
+	for _, compressed := range []bool{false, true} {
+		t.Run(fmt.Sprintf("gzip %t", compressed), func(t *testing.T) {
+			directory := t.TempDir()
+			records := testRecords(50)
+
+			// Small files make the writer rotate several times
+			writeRecords(t, protocols.JournalParams{
+				Directory:   directory,
+				MaxFileSize: 512,
+				Gzip:        compressed,
+			}, records)
+
+			entries, err := os.ReadDir(directory)
+			switch {
+			case err != nil:
+				t.Fatal(err)
+			case len(entries) < 2:
+				t.Fatalf("journal was not rotated, %d files written", len(entries))
+			}
+
+			reader, err := journal.NewReader(directory)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer reader.Close()
+
+			for i, expected := range records {
+				record, err := reader.Next()
+				if err != nil {
+					t.Fatalf("record %d: %s", i, err)
+				}
+
+				if record.RoutingKey != expected.RoutingKey || string(record.Body) != string(expected.Body) ||
+					!record.Timestamp.Equal(expected.Timestamp) || record.MessageInterest != expected.MessageInterest ||
+					record.Session != expected.Session {
+					t.Fatalf("record %d differs - expected %+v, got %+v", i, expected, *record)
+				}
+			}
+
+			if _, err := reader.Next(); !errors.Is(err, io.EOF) {
+				t.Fatalf("expected end of journal, got %v", err)
+			}
+		})
+	}
+}
+
+func TestPlayerSelection(t *testing.T) {
+	directory := t.TempDir()
+	records := []journal.Record{
+		{RoutingKey: "-.-.-.alive.-.-.-.1", MessageInterest: protocols.SystemAliveOnly.Name(), Session: 3},
+		{RoutingKey: "-.-.-.alive.-.-.-.1", MessageInterest: protocols.LiveOnlyMessageInterest.Name(), Session: 1},
+		{RoutingKey: "hi.-.live.odds_change.1.od:match.1.-", MessageInterest: protocols.LiveOnlyMessageInterest.Name(), Session: 1},
+		{RoutingKey: "-.-.-.alive.-.-.-.1", MessageInterest: protocols.SpecifiedMatchesOnlyMessageInterest.Name(), Session: 2},
+		{RoutingKey: "hi.-.live.bet_stop.1.od:match.2.-", MessageInterest: protocols.SpecifiedMatchesOnlyMessageInterest.Name(), Session: 2},
+	}
+	writeRecords(t, protocols.JournalParams{Directory: directory}, records)
+
+	tests := []struct {
+		name     string
+		params   protocols.JournalPlayParams
+		expected []int
+	}{
+		{name: "message interest", params: protocols.JournalPlayParams{MessageInterest: protocols.LiveOnlyMessageInterest}, expected: []int{1, 2}},
+		{name: "specified matches", params: protocols.JournalPlayParams{MessageInterest: protocols.SpecifiedMatchesOnlyMessageInterest}, expected: []int{3, 4}},
+		{name: "session", params: protocols.JournalPlayParams{Session: 2}, expected: []int{3, 4}},
+		{name: "all", params: protocols.JournalPlayParams{All: true}, expected: []int{1, 2, 3, 4}},
+		{name: "system alive", params: protocols.JournalPlayParams{MessageInterest: protocols.SystemAliveOnly}, expected: []int{0}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			transport := feed.NewMemoryTransport(utils.SystemClock{})
+			if err := transport.Open(); err != nil {
+				t.Fatal(err)
+			}
+			defer transport.Close()
+
+			channel, err := transport.CreateChannel(exchangeName, []string{"#"}, protocols.ChannelOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			params := test.params
+			params.Directory = directory
+			if err := journal.NewPlayer(transport, exchangeName, params).Play(); err != nil {
+				t.Fatal(err)
+			}
+
+			for _, i := range test.expected {
+				delivery := <-channel.Deliveries()
+				if delivery.RoutingKey != records[i].RoutingKey {
+					t.Fatalf("expected record %d %s, got %s", i, records[i].RoutingKey, delivery.RoutingKey)
+				}
+			}
+
+			select {
+			case delivery := <-channel.Deliveries():
+				t.Fatalf("unexpected delivery %s", delivery.RoutingKey)
+			default:
+			}
+		})
+	}
+}
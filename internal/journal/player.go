@@ -0,0 +1,103 @@
+package journal
+
+import (
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/oddin-gg/gosdk/protocols"
+)
+
+// Player publishes journal records into in-memory transport at configured pace
+type Player struct {
+	transport    protocols.InMemoryTransport
+	exchangeName string
+	params       protocols.JournalPlayParams
+	closeCh      chan struct{}
+	closeOnce    sync.Once
+}
+
+// Play ...
+func (p *Player) Play() error {
+	reader, err := NewReader(p.params.Directory)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	var previous time.Time
+	for {
+		record, err := reader.Next()
+		switch {
+		case errors.Is(err, io.EOF):
+			return nil
+		case err != nil:
+			return err
+		}
+
+		if !p.selected(record) {
+			continue
+		}
+
+		if p.params.Speed > 0 && !previous.IsZero() && record.ReceivedAt.After(previous) {
+			delay := time.Duration(float64(record.ReceivedAt.Sub(previous)) / p.params.Speed)
+			select {
+			case <-time.After(delay):
+			case <-p.closeCh:
+				return nil
+			}
+		}
+		previous = record.ReceivedAt
+
+		if p.isStopped() {
+			return nil
+		}
+
+		err = p.transport.Publish(p.exchangeName, protocols.TransportDelivery{
+			RoutingKey: record.RoutingKey,
+			Body:       record.Body,
+			Timestamp:  record.Timestamp,
+		})
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (p *Player) selected(record *Record) bool {
+	switch {
+	case p.params.Session != 0:
+		return record.Session == p.params.Session
+	case p.params.All:
+		return record.MessageInterest != protocols.SystemAliveOnly.Name()
+	default:
+		return record.MessageInterest == p.params.MessageInterest.Name()
+	}
+}
+
+// Stop ...
+func (p *Player) Stop() {
+	p.closeOnce.Do(func() {
+		close(p.closeCh)
+	})
+}
+
+func (p *Player) isStopped() bool {
+	select {
+	case <-p.closeCh:
+		return true
+	default:
+		return false
+	}
+}
+
+// NewPlayer ...
+func NewPlayer(transport protocols.InMemoryTransport, exchangeName string, params protocols.JournalPlayParams) *Player {
+	return &Player{
+		transport:    transport,
+		exchangeName: exchangeName,
+		params:       params,
+		closeCh:      make(chan struct{}),
+	}
+}
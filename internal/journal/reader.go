@@ -0,0 +1,125 @@
+package journal
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const maxRecordSize = 64 * 1024 * 1024
+
+// Reader reads records from all journal files in a directory in the order they were written
+type Reader struct {
+	files   []string
+	file    *os.File
+	gzip    *gzip.Reader
+	scanner *bufio.Scanner
+}
+
+// Next returns io.EOF once all journal files are read
+func (r *Reader) Next() (*Record, error) {
+	for {
+		if r.scanner == nil {
+			if len(r.files) == 0 {
+				return nil, io.EOF
+			}
+
+			err := r.openFile(r.files[0])
+			if err != nil {
+				return nil, err
+			}
+			r.files = r.files[1:]
+		}
+
+		if !r.scanner.Scan() {
+			err := r.scanner.Err()
+			r.closeFile()
+			if err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		var record Record
+		err := json.Unmarshal(r.scanner.Bytes(), &record)
+		if err != nil {
+			return nil, err
+		}
+
+		return &record, nil
+	}
+}
+
+// Close ...
+func (r *Reader) Close() {
+	r.closeFile()
+	r.files = nil
+}
+
+func (r *Reader) openFile(name string) error {
+	file, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+
+	var input io.Reader = file
+	if strings.HasSuffix(name, gzipExtension) {
+		r.gzip, err = gzip.NewReader(file)
+		if err != nil {
+			_ = file.Close()
+			return err
+		}
+		input = r.gzip
+	}
+
+	r.file = file
+	r.scanner = bufio.NewScanner(input)
+	r.scanner.Buffer(make([]byte, 0, 64*1024), maxRecordSize)
+
+	return nil
+}
+
+func (r *Reader) closeFile() {
+	if r.gzip != nil {
+		_ = r.gzip.Close()
+		r.gzip = nil
+	}
+
+	if r.file != nil {
+		_ = r.file.Close()
+		r.file = nil
+	}
+
+	r.scanner = nil
+}
+
+// NewReader ...
+func NewReader(directory string) (*Reader, error) {
+	entries, err := os.ReadDir(directory)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, filePrefix) {
+			continue
+		}
+
+		if strings.HasSuffix(name, fileExtension) || strings.HasSuffix(name, fileExtension+gzipExtension) {
+			files = append(files, filepath.Join(directory, name))
+		}
+	}
+	sort.Strings(files)
+
+	return &Reader{
+		files: files,
+	}, nil
+}
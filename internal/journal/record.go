@@ -0,0 +1,23 @@
+package journal
+
+import "time"
+
+const (
+	filePrefix    = "journal-"
+	fileExtension = ".jsonl"
+	gzipExtension = ".gz"
+)
+
+// Record is a single raw delivery stored in the journal, every record is one JSON line
+type Record struct {
+	RoutingKey string `json:"routing_key"`
+	// Timestamp of the delivery set by the broker
+	Timestamp  time.Time `json:"timestamp"`
+	ReceivedAt time.Time `json:"received_at"`
+	// Body is stored as base64 string
+	Body []byte `json:"body"`
+	// MessageInterest is the name of message interest of the session which consumed the delivery
+	MessageInterest string `json:"message_interest"`
+	// Session is the order in which the session was built, implicit system alive session is built last
+	Session int `json:"session"`
+}
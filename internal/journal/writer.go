@@ -0,0 +1,139 @@
+package journal
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/oddin-gg/gosdk/protocols"
+)
+
+const defaultMaxFileSize = 100 * 1024 * 1024
+
+// Writer appends records to rotating journal files, it is safe for concurrent use
+type Writer struct {
+	lock     sync.Mutex
+	params   protocols.JournalParams
+	clock    protocols.Clock
+	file     *os.File
+	gzip     *gzip.Writer
+	output   io.Writer
+	size     int64
+	sequence int
+	closed   bool
+}
+
+// Write appends record to the current journal file, file is rotated once it reaches max size
+func (w *Writer) Write(record Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if w.closed {
+		return errors.New("journal is closed")
+	}
+
+	if w.output != nil && w.size+int64(len(data)) > w.params.MaxFileSize {
+		err = w.closeFile()
+		if err != nil {
+			return err
+		}
+	}
+
+	if w.output == nil {
+		err = w.openFile()
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = w.output.Write(data)
+	if err != nil {
+		return err
+	}
+	w.size += int64(len(data))
+
+	if w.gzip != nil {
+		// Flush every record so the journal is readable up to the last record after a crash
+		return w.gzip.Flush()
+	}
+
+	return nil
+}
+
+// Close ...
+func (w *Writer) Close() error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	w.closed = true
+	return w.closeFile()
+}
+
+func (w *Writer) openFile() error {
+	w.sequence++
+	name := fmt.Sprintf("%s%s-%04d%s", filePrefix, w.clock.Now().UTC().Format("20060102T150405"), w.sequence, fileExtension)
+	if w.params.Gzip {
+		name += gzipExtension
+	}
+
+	file, err := os.OpenFile(filepath.Join(w.params.Directory, name), os.O_WRONLY|os.O_CREATE|os.O_EXCL|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+
+	w.file = file
+	w.output = file
+	w.size = 0
+
+	if w.params.Gzip {
+		w.gzip = gzip.NewWriter(file)
+		w.output = w.gzip
+	}
+
+	return nil
+}
+
+func (w *Writer) closeFile() error {
+	if w.file == nil {
+		return nil
+	}
+
+	var err error
+	if w.gzip != nil {
+		err = w.gzip.Close()
+	}
+
+	closeErr := w.file.Close()
+	if err == nil {
+		err = closeErr
+	}
+
+	w.file = nil
+	w.gzip = nil
+	w.output = nil
+
+	return err
+}
+
+// NewWriter creates writer which names journal files by time of the clock
+func NewWriter(params protocols.JournalParams, clock protocols.Clock) *Writer {
+	if params.MaxFileSize <= 0 {
+		params.MaxFileSize = defaultMaxFileSize
+	}
+
+	return &Writer{
+		params: params,
+		clock:  clock,
+	}
+}
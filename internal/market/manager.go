@@ -1,6 +1,8 @@
 package market
 
 import (
+	"context"
+
 	"github.com/oddin-gg/gosdk/internal/cache"
 	"github.com/oddin-gg/gosdk/internal/factory"
 	"github.com/oddin-gg/gosdk/protocols"
@@ -8,6 +10,7 @@ import (
 
 // Manager ...
 type Manager struct {
+	ctx                      context.Context
 	oddsFeedConfiguration    protocols.OddsFeedConfiguration
 	marketDescriptionFactory *factory.MarketDescriptionFactory
 	cacheManager             *cache.Manager
@@ -24,12 +27,12 @@ func (m Manager) MarketDescriptionByIDAndVariant(
 	variant *string,
 ) (protocols.MarketDescription, error) {
 	locale := []protocols.Locale{m.oddsFeedConfiguration.DefaultLocale()}
-	return m.marketDescriptionFactory.MarketDescriptionByIDAndVariant(marketID, variant, locale)
+	return m.marketDescriptionFactory.MarketDescriptionByIDAndVariant(m.ctx, marketID, variant, locale)
 }
 
 // LocalizedMarketDescriptions ...
 func (m Manager) LocalizedMarketDescriptions(locale protocols.Locale) ([]protocols.MarketDescription, error) {
-	return m.marketDescriptionFactory.MarketDescriptions(locale)
+	return m.marketDescriptionFactory.MarketDescriptions(m.ctx, locale)
 }
 
 // ClearMarketDescription ...
@@ -39,17 +42,24 @@ func (m Manager) ClearMarketDescription(marketID uint, variant *string) {
 
 // MarketVoidReasons ...
 func (m Manager) MarketVoidReasons() ([]protocols.MarketVoidReason, error) {
-	return m.marketDescriptionFactory.MarketVoidReasons()
+	return m.marketDescriptionFactory.MarketVoidReasons(m.ctx)
 }
 
 // ReloadMarketVoidReasons ...
 func (m Manager) ReloadMarketVoidReasons() ([]protocols.MarketVoidReason, error) {
-	return m.marketDescriptionFactory.ReloadMarketVoidReasons()
+	return m.marketDescriptionFactory.ReloadMarketVoidReasons(m.ctx)
+}
+
+// WithContext returns manager which uses ctx for API calls
+func (m Manager) WithContext(ctx context.Context) protocols.MarketDescriptionManager {
+	m.ctx = ctx
+	return m
 }
 
 // NewManager ...
 func NewManager(cacheManager *cache.Manager, marketDescriptionFactory *factory.MarketDescriptionFactory, oddsFeedConfiguration protocols.OddsFeedConfiguration) *Manager {
 	return &Manager{
+		ctx:                      context.Background(),
 		oddsFeedConfiguration:    oddsFeedConfiguration,
 		marketDescriptionFactory: marketDescriptionFactory,
 		cacheManager:             cacheManager,
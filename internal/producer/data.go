@@ -52,6 +52,7 @@ type producerImpl struct {
 	producerScopes                  []protocols.ProducerScope
 	statefulRecoveryWindowInMinutes uint
 	producerData                    *data
+	clock                           protocols.Clock
 }
 
 func (p producerImpl) ID() uint {
@@ -110,7 +111,7 @@ func (p producerImpl) LastProcessedMessageGenTimestamp() time.Time {
 }
 
 func (p producerImpl) ProcessingQueDelay() time.Duration {
-	return time.Since(p.LastProcessedMessageGenTimestamp())
+	return p.clock.Now().Sub(p.LastProcessedMessageGenTimestamp())
 }
 
 func (p producerImpl) TimestampForRecovery() time.Time {
@@ -132,10 +133,14 @@ func (p producerImpl) StatefulRecoveryWindowInMinutes() uint {
 }
 
 func (p producerImpl) RecoveryInfo() *protocols.RecoveryInfo {
-	panic("implement me")
+	if p.producerData == nil || p.producerData.lastRecoveryInfo == nil {
+		return nil
+	}
+
+	return &p.producerData.lastRecoveryInfo
 }
 
-func buildProducerImpl(producerData *data) (*producerImpl, error) {
+func buildProducerImpl(producerData *data, clock protocols.Clock) (*producerImpl, error) {
 	var producerScopes []protocols.ProducerScope
 
 	for _, scope := range strings.Split(string(producerData.producerScope), "|") {
@@ -153,6 +158,9 @@ func buildProducerImpl(producerData *data) (*producerImpl, error) {
 		return nil, fmt.Errorf("unknown producer scopes %s", producerData.producerScope)
 	}
 
+	// Producer is a snapshot, the data is changed by the manager under its lock
+	snapshot := *producerData
+
 	return &producerImpl{
 		id:                              producerData.id,
 		active:                          producerData.active,
@@ -162,7 +170,8 @@ func buildProducerImpl(producerData *data) (*producerImpl, error) {
 		apiEndpoint:                     producerData.apiEndpoint,
 		producerScopes:                  producerScopes,
 		statefulRecoveryWindowInMinutes: producerData.statefulRecoveryWindowInMinutes,
-		producerData:                    producerData,
+		producerData:                    &snapshot,
+		clock:                           clock,
 	}, nil
 }
 
@@ -180,5 +189,6 @@ func buildProducerImplFromUnknown(unknownProducerID uint, cfg protocols.OddsFeed
 		apiEndpoint:                     apiURL,
 		producerScopes:                  []protocols.ProducerScope{protocols.LiveProducerScope, protocols.PrematchProducerScope},
 		statefulRecoveryWindowInMinutes: statefulRecoveryMinutes,
+		clock:                           cfg.Clock(),
 	}, nil
 }
@@ -1,8 +1,10 @@
 package producer
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/oddin-gg/gosdk/internal/api"
@@ -12,21 +14,46 @@ import (
 
 // Manager ...
 type Manager struct {
+	ctx context.Context
+	*state
+}
+
+// state is shared by managers bound to different contexts
+type state struct {
 	apiClient   *api.Client
 	cfg         protocols.OddsFeedConfiguration
 	logger      *log.Entry
+	lock        sync.RWMutex
 	producerMap map[uint]*data
+	watchLock   sync.Mutex
+	watchers    map[chan protocols.ProducerChange]struct{}
+	closeCh     chan struct{}
+}
+
+// WithContext returns manager which uses ctx when producers have to be fetched from API
+func (m *Manager) WithContext(ctx context.Context) protocols.ProducerManager {
+	return &Manager{
+		ctx:   ctx,
+		state: m.state,
+	}
 }
 
 func (m *Manager) producers() (map[uint]*data, error) {
-	if m.producerMap != nil {
-		return m.producerMap, nil
+	m.lock.RLock()
+	producerMap := m.producerMap
+	m.lock.RUnlock()
+
+	if producerMap != nil {
+		return producerMap, nil
 	}
 
-	if err := m.Open(); err != nil {
+	if err := m.Open(m.ctx); err != nil {
 		return nil, err
 	}
 
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
 	return m.producerMap, nil
 }
 
@@ -44,34 +71,81 @@ func (m *Manager) producer(id uint) (*data, error) {
 	return producer, nil
 }
 
+// update changes the producer under the lock and notifies watchers when update reports a change, watchers are
+// notified under the lock so they receive changes in order
+func (m *Manager) update(id uint, changeType protocols.ProducerChangeType, update func(producer *data) (bool, error)) error {
+	producer, err := m.producer(id)
+	if err != nil {
+		return err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	changed, err := update(producer)
+	if err != nil || !changed || !m.watching() {
+		return err
+	}
+
+	// Producer was already changed, failure of the notification is not failure of the update
+	snapshot, err := buildProducerImpl(producer, m.cfg.Clock())
+	if err != nil {
+		m.logger.WithError(err).Errorf("failed to notify watchers about change of producer %d", id)
+		return nil
+	}
+
+	m.notify(protocols.ProducerChange{
+		Type:     changeType,
+		Producer: snapshot,
+	})
+
+	return nil
+}
+
+// read returns value of the producer read under the lock
+func read[T any](m *Manager, id uint, get func(producer *data) T) (T, error) {
+	producer, err := m.producer(id)
+	if err != nil {
+		var empty T
+		return empty, err
+	}
+
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return get(producer), nil
+}
+
 // Open ...
-func (m *Manager) Open() error {
-	apiProducers, err := m.apiClient.FetchProducers()
+func (m *Manager) Open(ctx context.Context) error {
+	apiProducers, err := m.apiClient.FetchProducers(ctx)
 	if err != nil {
 		return err
 	}
 
 	m.logger.Debugf("fetched producer list - size %d", len(apiProducers))
 
-	m.producerMap = make(map[uint]*data, len(apiProducers))
+	producerMap := make(map[uint]*data, len(apiProducers))
 	for i := range apiProducers {
 		p := apiProducers[i]
-		m.producerMap[p.ID] = newData(p)
+		producerMap[p.ID] = newData(p)
 	}
 
+	m.lock.Lock()
+	m.producerMap = producerMap
+	m.lock.Unlock()
+
 	m.logger.Debugf("mapped producer list - %v", apiProducers)
 	return nil
 }
 
 // SetProducerDown ...
 func (m *Manager) SetProducerDown(id uint, flaggedDown bool) error {
-	producer, err := m.producer(id)
-	if err != nil {
-		return err
-	}
-
-	producer.flaggedDown = flaggedDown
-	return nil
+	return m.update(id, protocols.FlaggedDownProducerChangeType, func(producer *data) (bool, error) {
+		changed := producer.flaggedDown != flaggedDown
+		producer.flaggedDown = flaggedDown
+		return changed, nil
+	})
 }
 
 // SetProducerLastMessageTimestamp ...
@@ -79,46 +153,38 @@ func (m *Manager) SetProducerLastMessageTimestamp(id uint, timestamp time.Time)
 	if timestamp.IsZero() {
 		return errors.New("required non zero timestamp")
 	}
-	producer, err := m.producer(id)
-	if err != nil {
-		return err
-	}
 
-	producer.lastMessageTimestamp = timestamp
-	return nil
+	return m.update(id, protocols.LastMessageTimestampProducerChangeType, func(producer *data) (bool, error) {
+		changed := !producer.lastMessageTimestamp.Equal(timestamp)
+		producer.lastMessageTimestamp = timestamp
+		return changed, nil
+	})
 }
 
 // SetLastProcessedMessageGenTimestamp ...
 func (m *Manager) SetLastProcessedMessageGenTimestamp(id uint, timestamp time.Time) error {
-	producer, err := m.producer(id)
-	if err != nil {
-		return err
-	}
-
-	producer.lastProcessedMessageGenTimestamp = timestamp
-	return nil
+	return m.update(id, protocols.LastProcessedMessageGenTimestampProducerChangeType, func(producer *data) (bool, error) {
+		changed := !producer.lastProcessedMessageGenTimestamp.Equal(timestamp)
+		producer.lastProcessedMessageGenTimestamp = timestamp
+		return changed, nil
+	})
 }
 
 // SetLastAliveReceivedGenTimestamp ...
 func (m *Manager) SetLastAliveReceivedGenTimestamp(id uint, timestamp time.Time) error {
-	producer, err := m.producer(id)
-	if err != nil {
-		return err
-	}
-
-	producer.lastAliveReceivedGenTimestamp = timestamp
-	return nil
+	return m.update(id, protocols.LastAliveReceivedGenTimestampProducerChangeType, func(producer *data) (bool, error) {
+		changed := !producer.lastAliveReceivedGenTimestamp.Equal(timestamp)
+		producer.lastAliveReceivedGenTimestamp = timestamp
+		return changed, nil
+	})
 }
 
 // SetProducerRecoveryInfo ...
 func (m *Manager) SetProducerRecoveryInfo(id uint, recoveryInfo protocols.RecoveryInfo) error {
-	producer, err := m.producer(id)
-	if err != nil {
-		return err
-	}
-
-	producer.lastRecoveryInfo = recoveryInfo
-	return nil
+	return m.update(id, protocols.RecoveryInfoProducerChangeType, func(producer *data) (bool, error) {
+		producer.lastRecoveryInfo = recoveryInfo
+		return true, nil
+	})
 }
 
 // AvailableProducers ...
@@ -128,10 +194,13 @@ func (m *Manager) AvailableProducers() (map[uint]protocols.Producer, error) {
 		return nil, err
 	}
 
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
 	res := make(map[uint]protocols.Producer, len(producers))
 	for i := range producers {
 		data := producers[i]
-		res[i], err = buildProducerImpl(data)
+		res[i], err = buildProducerImpl(data, m.cfg.Clock())
 		if err != nil {
 			return nil, err
 		}
@@ -147,6 +216,9 @@ func (m *Manager) ActiveProducers() (map[uint]protocols.Producer, error) {
 		return nil, err
 	}
 
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
 	res := make(map[uint]protocols.Producer, len(producers))
 	for i := range producers {
 		data := producers[i]
@@ -154,7 +226,7 @@ func (m *Manager) ActiveProducers() (map[uint]protocols.Producer, error) {
 			continue
 		}
 
-		res[i], err = buildProducerImpl(data)
+		res[i], err = buildProducerImpl(data, m.cfg.Clock())
 		if err != nil {
 			return nil, err
 		}
@@ -170,6 +242,9 @@ func (m *Manager) ActiveProducersInScope(scope protocols.ProducerScope) (map[uin
 		return nil, err
 	}
 
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
 	res := make(map[uint]protocols.Producer, len(producers))
 	for i := range producers {
 		data := producers[i]
@@ -177,7 +252,7 @@ func (m *Manager) ActiveProducersInScope(scope protocols.ProducerScope) (map[uin
 			continue
 		}
 
-		p, err := buildProducerImpl(data)
+		p, err := buildProducerImpl(data, m.cfg.Clock())
 		if err != nil {
 			return nil, err
 		}
@@ -208,64 +283,135 @@ func (m *Manager) GetProducer(id uint) (protocols.Producer, error) {
 		return buildProducerImplFromUnknown(id, m.cfg)
 	}
 
-	return buildProducerImpl(producer)
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return buildProducerImpl(producer, m.cfg.Clock())
 }
 
 // SetProducerState ...
 func (m *Manager) SetProducerState(id uint, enabled bool) error {
-	producer, err := m.producer(id)
-	if err != nil {
-		return err
-	}
-
-	producer.enabled = enabled
-	return nil
+	return m.update(id, protocols.EnabledProducerChangeType, func(producer *data) (bool, error) {
+		changed := producer.enabled != enabled
+		producer.enabled = enabled
+		return changed, nil
+	})
 }
 
 // SetProducerRecoveryFromTimestamp ...
 func (m *Manager) SetProducerRecoveryFromTimestamp(id uint, timestamp time.Time) error {
-	producer, err := m.producer(id)
-	if err != nil {
-		return err
-	}
-
-	maxRequestMinutes := producer.statefulRecoveryWindowInMinutes
-	switch {
-	case timestamp.IsZero():
-		break
-	case time.Since(timestamp).Minutes() > float64(maxRequestMinutes):
-		return errors.New("last received message timestamp can not be so long in past")
-	}
+	return m.update(id, protocols.RecoveryFromTimestampProducerChangeType, func(producer *data) (bool, error) {
+		maxRequestMinutes := producer.statefulRecoveryWindowInMinutes
+		switch {
+		case timestamp.IsZero():
+			break
+		case m.cfg.Clock().Now().Sub(timestamp).Minutes() > float64(maxRequestMinutes):
+			return false, errors.New("last received message timestamp can not be so long in past")
+		}
 
-	producer.recoveryFromTimestamp = timestamp
-	return nil
+		changed := !producer.recoveryFromTimestamp.Equal(timestamp)
+		producer.recoveryFromTimestamp = timestamp
+		return changed, nil
+	})
 }
 
 // IsProducerEnabled ...
 func (m *Manager) IsProducerEnabled(id uint) (bool, error) {
-	producer, err := m.producer(id)
-	if err != nil {
-		return false, err
-	}
-
-	return producer.enabled, nil
+	return read(m, id, func(producer *data) bool {
+		return producer.enabled
+	})
 }
 
 // IsProducerDown ...
 func (m *Manager) IsProducerDown(id uint) (bool, error) {
-	producer, err := m.producer(id)
-	if err != nil {
-		return false, err
+	return read(m, id, func(producer *data) bool {
+		return producer.flaggedDown
+	})
+}
+
+// Watch ...
+func (m *Manager) Watch(ctx context.Context, bufferSize int) <-chan protocols.ProducerChange {
+	ch := make(chan protocols.ProducerChange, bufferSize)
+
+	m.watchLock.Lock()
+	defer m.watchLock.Unlock()
+
+	select {
+	case <-m.closeCh:
+		close(ch)
+		return ch
+	default:
 	}
 
-	return producer.flaggedDown, nil
+	m.watchers[ch] = struct{}{}
+
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-m.closeCh:
+			return
+		}
+
+		m.watchLock.Lock()
+		defer m.watchLock.Unlock()
+
+		if _, ok := m.watchers[ch]; ok {
+			delete(m.watchers, ch)
+			close(ch)
+		}
+	}()
+
+	return ch
+}
+
+// Close closes channels of all watchers, channels returned by Watch afterwards are closed immediately
+func (m *Manager) Close() {
+	m.watchLock.Lock()
+	defer m.watchLock.Unlock()
+
+	select {
+	case <-m.closeCh:
+		return
+	default:
+	}
+
+	close(m.closeCh)
+	for ch := range m.watchers {
+		delete(m.watchers, ch)
+		close(ch)
+	}
+}
+
+func (m *Manager) watching() bool {
+	m.watchLock.Lock()
+	defer m.watchLock.Unlock()
+
+	return len(m.watchers) != 0
+}
+
+func (m *Manager) notify(change protocols.ProducerChange) {
+	m.watchLock.Lock()
+	defer m.watchLock.Unlock()
+
+	for ch := range m.watchers {
+		select {
+		case ch <- change:
+		default:
+			// Slow watcher must not block message processing
+		}
+	}
 }
 
 // NewManager ...
 func NewManager(cfg protocols.OddsFeedConfiguration, apiClient *api.Client, logger *log.Entry) *Manager {
 	return &Manager{
-		apiClient: apiClient,
-		cfg:       cfg,
-		logger:    logger,
+		ctx: context.Background(),
+		state: &state{
+			apiClient: apiClient,
+			cfg:       cfg,
+			logger:    logger,
+			watchers:  make(map[chan protocols.ProducerChange]struct{}),
+			closeCh:   make(chan struct{}),
+		},
 	}
 }
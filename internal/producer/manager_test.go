@@ -0,0 +1,192 @@
+package producer
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oddin-gg/gosdk/internal/api/xml"
+	"github.com/oddin-gg/gosdk/protocols"
+	log "github.com/sirupsen/logrus"
+)
+
+type testClock struct {
+	protocols.Clock
+}
+
+func (testClock) Now() time.Time {
+	return time.Now()
+}
+
+type testConfiguration struct {
+	protocols.OddsFeedConfiguration
+}
+
+func (testConfiguration) Clock() protocols.Clock {
+	return testClock{}
+}
+
+func newTestManager(producers ...xml.Producer) *Manager {
+	m := NewManager(testConfiguration{}, nil, log.NewEntry(log.New()))
+	m.producerMap = make(map[uint]*data, len(producers))
+	for _, p := range producers {
+		m.producerMap[p.ID] = newData(p)
+	}
+
+	return m
+}
+
+func testProducer(id uint, scope xml.Scope) xml.Producer {
+	return xml.Producer{
+		ID:             id,
+		Name:           "producer",
+		Active:         true,
+		Scope:          scope,
+		RecoveryWindow: 60,
+	}
+}
+
+func TestManagerConcurrentAccess(t *testing.T) {
+	m := newTestManager(testProducer(1, xml.ScopeLive), testProducer(2, xml.ScopePrematch))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	changes := m.Watch(ctx, 10)
+
+	watched := make(chan struct{})
+	go func() {
+		defer close(watched)
+		for change := range changes {
+			_ = change.Producer.LastMessageTimestamp()
+			_ = change.Producer.IsFlaggedDown()
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func(id uint) {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				now := time.Now()
+				if err := m.SetProducerLastMessageTimestamp(id, now); err != nil {
+					t.Error(err)
+				}
+				if err := m.SetLastProcessedMessageGenTimestamp(id, now); err != nil {
+					t.Error(err)
+				}
+				if err := m.SetProducerDown(id, j%2 == 0); err != nil {
+					t.Error(err)
+				}
+			}
+		}(uint(i%2 + 1))
+
+		go func(id uint) {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				producer, err := m.GetProducer(id)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				_ = producer.LastMessageTimestamp()
+				_ = producer.ProcessingQueDelay()
+
+				if _, err := m.IsProducerDown(id); err != nil {
+					t.Error(err)
+				}
+				if _, err := m.ActiveProducers(); err != nil {
+					t.Error(err)
+				}
+			}
+		}(uint(i%2 + 1))
+	}
+
+	wg.Wait()
+	cancel()
+	<-watched
+}
+
+func TestManagerWatchOrder(t *testing.T) {
+	m := newTestManager(testProducer(1, xml.ScopeLive))
+	changes := m.Watch(context.Background(), 1000)
+
+	var wg sync.WaitGroup
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(offset int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				timestamp := start.Add(time.Duration(j*4+offset) * time.Millisecond)
+				if err := m.SetProducerLastMessageTimestamp(1, timestamp); err != nil {
+					t.Error(err)
+				}
+			}
+		}(i)
+	}
+
+	wg.Wait()
+	m.Close()
+
+	last, err := m.GetProducer(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var received protocols.Producer
+	for change := range changes {
+		received = change.Producer
+	}
+
+	switch {
+	case received == nil:
+		t.Fatal("no change received")
+	case !received.LastMessageTimestamp().Equal(last.LastMessageTimestamp()):
+		t.Fatalf("last change %s does not match producer %s", received.LastMessageTimestamp(), last.LastMessageTimestamp())
+	}
+}
+
+func TestManagerCloseClosesWatchers(t *testing.T) {
+	m := newTestManager(testProducer(1, xml.ScopeLive))
+	changes := m.Watch(context.Background(), 1)
+
+	m.Close()
+	m.Close()
+
+	if _, ok := <-changes; ok {
+		t.Fatal("watcher channel is not closed")
+	}
+
+	if _, ok := <-m.Watch(context.Background(), 1); ok {
+		t.Fatal("watcher channel of closed manager is not closed")
+	}
+
+	if err := m.SetProducerDown(1, false); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestManagerUpdateWithoutSnapshot(t *testing.T) {
+	m := newTestManager(testProducer(1, "unknown"))
+	changes := m.Watch(context.Background(), 1)
+	defer m.Close()
+
+	if err := m.SetProducerDown(1, false); err != nil {
+		t.Fatalf("update failed after producer was changed: %s", err)
+	}
+
+	down, err := m.IsProducerDown(1)
+	switch {
+	case err != nil:
+		t.Fatal(err)
+	case down:
+		t.Fatal("producer was not changed")
+	}
+
+	select {
+	case change := <-changes:
+		t.Fatalf("unexpected change %d", change.Type)
+	default:
+	}
+}
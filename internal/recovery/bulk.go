@@ -0,0 +1,280 @@
+package recovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/oddin-gg/gosdk/protocols"
+)
+
+// bulkTimeoutCheckPeriod is the precision of bulk event recovery timeouts
+const bulkTimeoutCheckPeriod = time.Second
+
+type bulkEventRecovery struct {
+	lock      sync.Mutex
+	progress  []protocols.EventRecoveryProgress
+	indexes   map[uint]int
+	deadlines map[uint]time.Time
+	pending   int
+	done      chan struct{}
+}
+
+// Progress ...
+func (b *bulkEventRecovery) Progress() []protocols.EventRecoveryProgress {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	result := make([]protocols.EventRecoveryProgress, len(b.progress))
+	copy(result, b.progress)
+	return result
+}
+
+// Done ...
+func (b *bulkEventRecovery) Done() <-chan struct{} {
+	return b.done
+}
+
+func (b *bulkEventRecovery) requested(index int, requestID uint) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.indexes[requestID] = index
+	b.progress[index].RequestID = requestID
+	b.progress[index].Status = protocols.RequestedEventRecoveryStatus
+}
+
+// accepted starts timeout of the request
+func (b *bulkEventRecovery) accepted(requestID uint, deadline time.Time) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if !b.progress[b.indexes[requestID]].Status.IsFinal() {
+		b.deadlines[requestID] = deadline
+	}
+}
+
+// expired returns requests accepted before the deadline which did not finish until now
+func (b *bulkEventRecovery) expired(now time.Time) []uint {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	var result []uint
+	for requestID, deadline := range b.deadlines {
+		if !now.Before(deadline) {
+			result = append(result, requestID)
+		}
+	}
+
+	return result
+}
+
+func (b *bulkEventRecovery) requestFinished(requestID uint, status protocols.EventRecoveryStatus) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	delete(b.deadlines, requestID)
+	index, ok := b.indexes[requestID]
+	if ok {
+		b.finish(index, status, nil)
+	}
+}
+
+func (b *bulkEventRecovery) failed(index int, err error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.finish(index, protocols.FailedEventRecoveryStatus, err)
+}
+
+// cancel fails all events from index on, which were not passed to the workers
+func (b *bulkEventRecovery) cancel(from int, err error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	for index := from; index < len(b.progress); index++ {
+		b.finish(index, protocols.FailedEventRecoveryStatus, err)
+	}
+}
+
+func (b *bulkEventRecovery) finish(index int, status protocols.EventRecoveryStatus, err error) {
+	if b.progress[index].Status.IsFinal() {
+		return
+	}
+
+	b.progress[index].Status = status
+	b.progress[index].Err = err
+
+	b.pending--
+	if b.pending == 0 {
+		close(b.done)
+	}
+}
+
+func newBulkEventRecovery(eventIDs []protocols.URN) *bulkEventRecovery {
+	progress := make([]protocols.EventRecoveryProgress, len(eventIDs))
+	for i := range eventIDs {
+		progress[i] = protocols.EventRecoveryProgress{
+			EventID: eventIDs[i],
+			Status:  protocols.PendingEventRecoveryStatus,
+		}
+	}
+
+	bulk := &bulkEventRecovery{
+		progress:  progress,
+		indexes:   make(map[uint]int, len(eventIDs)),
+		deadlines: make(map[uint]time.Time, len(eventIDs)),
+		pending:   len(eventIDs),
+		done:      make(chan struct{}),
+	}
+
+	if bulk.pending == 0 {
+		close(bulk.done)
+	}
+
+	return bulk
+}
+
+func (m *Manager) makeBulkEventRecovery(ctx context.Context, request protocols.BulkEventRecoveryRequest) (protocols.BulkEventRecovery, error) {
+	var callback func(context.Context, string, protocols.URN, uint, *int) (bool, error)
+	switch request.Kind {
+	case protocols.OddsEventRecoveryKind:
+		callback = m.apiClient.PostEventOddsRecovery
+	case protocols.StatefulEventRecoveryKind:
+		callback = m.apiClient.PostEventStatefulRecovery
+	default:
+		return nil, fmt.Errorf("unknown event recovery kind %d", request.Kind)
+	}
+
+	_, err := m.producerManager.GetProducer(request.ProducerID)
+	if err != nil {
+		return nil, err
+	}
+
+	concurrency := request.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	timeout := request.Timeout
+	if timeout <= 0 {
+		timeout = time.Duration(m.cfg.MaxRecoveryExecutionMinutes()) * time.Minute
+	}
+
+	eventIDs := make([]protocols.URN, len(request.EventIDs))
+	copy(eventIDs, request.EventIDs)
+	bulk := newBulkEventRecovery(eventIDs)
+
+	indexes := make(chan int)
+	go func() {
+		defer close(indexes)
+
+		var limiter <-chan time.Time
+		if request.RequestsPerSecond > 0 {
+			ticker := m.cfg.Clock().NewTicker(time.Duration(float64(time.Second) / request.RequestsPerSecond))
+			defer ticker.Stop()
+			limiter = ticker.C()
+		}
+
+		for index := range eventIDs {
+			if index > 0 && limiter != nil {
+				select {
+				case <-limiter:
+				case <-ctx.Done():
+					bulk.cancel(index, ctx.Err())
+					return
+				}
+			}
+
+			select {
+			case indexes <- index:
+			case <-ctx.Done():
+				bulk.cancel(index, ctx.Err())
+				return
+			}
+		}
+	}()
+
+	for i := 0; i < concurrency; i++ {
+		go func() {
+			for index := range indexes {
+				m.requestBulkEventRecovery(ctx, bulk, request.ProducerID, eventIDs[index], index, timeout, callback)
+			}
+		}()
+	}
+
+	go m.expireBulkEventRecovery(bulk, request.ProducerID, timeout)
+
+	return bulk, nil
+}
+
+// expireBulkEventRecovery times out accepted requests of the bulk recovery until all of them finish
+func (m *Manager) expireBulkEventRecovery(bulk *bulkEventRecovery, producerID uint, timeout time.Duration) {
+	ticker := m.cfg.Clock().NewTicker(min(timeout, bulkTimeoutCheckPeriod))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-bulk.done:
+			return
+		case now := <-ticker.C():
+			for _, requestID := range bulk.expired(now) {
+				if m.bulkEventRecoveryFinished(requestID, protocols.TimedOutEventRecoveryStatus) {
+					m.findOrMakeProducerRecoveryData(producerID).eventRecoveryCompleted(requestID)
+				}
+			}
+		}
+	}
+}
+
+func (m *Manager) requestBulkEventRecovery(
+	ctx context.Context,
+	bulk *bulkEventRecovery,
+	producerID uint,
+	eventID protocols.URN,
+	index int,
+	timeout time.Duration,
+	callback func(context.Context, string, protocols.URN, uint, *int) (bool, error),
+) {
+	requestID := m.sequence.next()
+
+	// Registered before the request, snapshot complete can arrive before the request returns
+	m.lock.Lock()
+	m.bulkRecoveries[requestID] = bulk
+	m.lock.Unlock()
+	bulk.requested(index, requestID)
+
+	success, err := m.requestEventRecovery(ctx, producerID, eventID, requestID, callback)
+	if err == nil && !success {
+		err = errors.New("event recovery request was not accepted")
+	}
+
+	if err != nil {
+		m.lock.Lock()
+		delete(m.bulkRecoveries, requestID)
+		m.lock.Unlock()
+
+		bulk.failed(index, err)
+		return
+	}
+
+	bulk.accepted(requestID, m.cfg.Clock().Now().Add(timeout))
+}
+
+// bulkEventRecoveryFinished returns false when the request is not part of any unfinished bulk recovery. Bulk requests
+// are tracked apart from event recoveries of the producer, which are dropped when the producer goes down.
+func (m *Manager) bulkEventRecoveryFinished(requestID uint, status protocols.EventRecoveryStatus) bool {
+	m.lock.Lock()
+	bulk, ok := m.bulkRecoveries[requestID]
+	delete(m.bulkRecoveries, requestID)
+	m.lock.Unlock()
+
+	if !ok {
+		return false
+	}
+
+	bulk.requestFinished(requestID, status)
+	return true
+}
@@ -0,0 +1,88 @@
+package recovery
+
+import (
+	"time"
+
+	"github.com/oddin-gg/gosdk/protocols"
+)
+
+// acquireRecovery returns false when recovery of the producer is performed by another node
+func (m *Manager) acquireRecovery(data *producerRecoveryData) (bool, error) {
+	coordinator := m.cfg.RecoveryCoordinator()
+	if coordinator == nil {
+		return true, nil
+	}
+
+	ttl := time.Duration(m.cfg.MaxRecoveryExecutionMinutes()) * time.Minute
+	return coordinator.AcquireRecovery(data.producerID, m.owner, m.cfg.Clock().Now(), ttl)
+}
+
+// releaseRecovery releases the producer lock, result is shared with other nodes unless it is nil
+func (m *Manager) releaseRecovery(data *producerRecoveryData, result *protocols.RecoveryResult) {
+	coordinator := m.cfg.RecoveryCoordinator()
+	if coordinator == nil {
+		return
+	}
+
+	err := coordinator.ReleaseRecovery(data.producerID, m.owner, result)
+	if err != nil {
+		m.logger.WithError(err).Errorf("failed to release recovery of producer %d", data.producerID)
+	}
+}
+
+// waitForRecovery marks the producer as waiting for recovery performed by another node
+func (m *Manager) waitForRecovery(data *producerRecoveryData) {
+	if data.recoveryWaitStartedAt.IsZero() {
+		data.recoveryWaitStartedAt = m.cfg.Clock().Now()
+		m.logger.Infof("recovery of producer %d is performed by another node", data.producerID)
+	}
+}
+
+// recoveredByOtherNode completes awaited recovery once another node with the same node id finished it, it is checked
+// before the lock is acquired again. Recovery messages of other node ids are not routed to this node, so the recovery
+// is requested again after the lock is released.
+func (m *Manager) recoveredByOtherNode(data *producerRecoveryData) (bool, error) {
+	if data.recoveryWaitStartedAt.IsZero() {
+		return false, nil
+	}
+
+	result, err := m.cfg.RecoveryCoordinator().LastRecovery(data.producerID)
+	if err != nil {
+		return false, err
+	}
+
+	if result == nil || result.FinishedAt.Before(data.recoveryWaitStartedAt) || !sameNodeID(result.NodeID, m.cfg.SdkNodeID()) {
+		return false, nil
+	}
+
+	m.logger.Infof("recovery of producer %d finished by another node for request %d", data.producerID, result.RequestID)
+	data.recoveryWaitStartedAt = time.Time{}
+	return true, m.completeSnapshotRecovery(data, result.RequestID, result.StartedAt)
+}
+
+// releaseRecoveries releases locks of recoveries which will never finish
+func (m *Manager) releaseRecoveries() {
+	// Transition lock is taken without holding the manager lock, transitions take the manager lock themselves
+	m.lock.RLock()
+	localRecoveryData := make([]*producerRecoveryData, 0, len(m.producerRecoveryData))
+	for _, data := range m.producerRecoveryData {
+		localRecoveryData = append(localRecoveryData, data)
+	}
+	m.lock.RUnlock()
+
+	for _, data := range localRecoveryData {
+		data.transition.Lock()
+		if data.isPerformingRecovery() {
+			m.releaseRecovery(data, nil)
+		}
+		data.transition.Unlock()
+	}
+}
+
+func sameNodeID(a *int, b *int) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	return *a == *b
+}
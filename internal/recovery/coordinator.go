@@ -0,0 +1,242 @@
+package recovery
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/oddin-gg/gosdk/protocols"
+)
+
+type recoveryLock struct {
+	Owner     string
+	ExpiresAt time.Time
+}
+
+func (r recoveryLock) isHeldBy(owner string, now time.Time) bool {
+	return r.Owner == owner || now.After(r.ExpiresAt)
+}
+
+// InMemoryCoordinator coordinates clients running in the same process
+type InMemoryCoordinator struct {
+	lock    sync.Mutex
+	locks   map[uint]recoveryLock
+	results map[uint]protocols.RecoveryResult
+}
+
+// AcquireRecovery ...
+func (i *InMemoryCoordinator) AcquireRecovery(producerID uint, owner string, now time.Time, ttl time.Duration) (bool, error) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	current, ok := i.locks[producerID]
+	if ok && !current.isHeldBy(owner, now) {
+		return false, nil
+	}
+
+	i.locks[producerID] = recoveryLock{
+		Owner:     owner,
+		ExpiresAt: now.Add(ttl),
+	}
+
+	return true, nil
+}
+
+// ReleaseRecovery ...
+func (i *InMemoryCoordinator) ReleaseRecovery(producerID uint, owner string, result *protocols.RecoveryResult) error {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	current, ok := i.locks[producerID]
+	if ok && current.Owner == owner {
+		delete(i.locks, producerID)
+	}
+
+	if result != nil {
+		i.results[producerID] = *result
+	}
+
+	return nil
+}
+
+// LastRecovery ...
+func (i *InMemoryCoordinator) LastRecovery(producerID uint) (*protocols.RecoveryResult, error) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	result, ok := i.results[producerID]
+	if !ok {
+		return nil, nil
+	}
+
+	return &result, nil
+}
+
+// NewInMemoryCoordinator ...
+func NewInMemoryCoordinator() *InMemoryCoordinator {
+	return &InMemoryCoordinator{
+		locks:   make(map[uint]recoveryLock),
+		results: make(map[uint]protocols.RecoveryResult),
+	}
+}
+
+// FileCoordinator coordinates clients sharing the directory. Lock of the producer is read and replaced while holding
+// an advisory lock of the producer mutex file, so the directory has to be on a file system supporting file locks.
+type FileCoordinator struct {
+	directory string
+}
+
+// AcquireRecovery ...
+func (f *FileCoordinator) AcquireRecovery(producerID uint, owner string, now time.Time, ttl time.Duration) (bool, error) {
+	var acquired bool
+	err := f.withMutex(producerID, func() error {
+		path := f.path(producerID, "lock")
+
+		var current recoveryLock
+		err := f.read(path, &current)
+		switch {
+		case errors.Is(err, os.ErrNotExist):
+		case err != nil:
+			return err
+		case !current.isHeldBy(owner, now):
+			return nil
+		}
+
+		content, err := json.Marshal(recoveryLock{
+			Owner:     owner,
+			ExpiresAt: now.Add(ttl),
+		})
+		if err != nil {
+			return err
+		}
+
+		err = f.write(path, content)
+		if err != nil {
+			return err
+		}
+
+		acquired = true
+		return nil
+	})
+
+	return acquired, err
+}
+
+// ReleaseRecovery ...
+func (f *FileCoordinator) ReleaseRecovery(producerID uint, owner string, result *protocols.RecoveryResult) error {
+	return f.withMutex(producerID, func() error {
+		if result != nil {
+			content, err := json.Marshal(result)
+			if err != nil {
+				return err
+			}
+
+			err = f.write(f.path(producerID, "json"), content)
+			if err != nil {
+				return err
+			}
+		}
+
+		path := f.path(producerID, "lock")
+		var current recoveryLock
+		err := f.read(path, &current)
+		switch {
+		case errors.Is(err, os.ErrNotExist):
+			return nil
+		case err != nil:
+			return err
+		case current.Owner != owner:
+			return nil
+		}
+
+		err = os.Remove(path)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+
+		return nil
+	})
+}
+
+// LastRecovery ...
+func (f *FileCoordinator) LastRecovery(producerID uint) (*protocols.RecoveryResult, error) {
+	var result protocols.RecoveryResult
+	err := f.read(f.path(producerID, "json"), &result)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (f *FileCoordinator) path(producerID uint, extension string) string {
+	return filepath.Join(f.directory, fmt.Sprintf("recovery-%d.%s", producerID, extension))
+}
+
+// withMutex runs fn while holding the advisory lock of the producer, mutex file is never removed
+// so all clients lock the same file
+func (f *FileCoordinator) withMutex(producerID uint, fn func() error) error {
+	file, err := os.OpenFile(f.path(producerID, "mutex"), os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	err = lockFile(file)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = unlockFile(file)
+	}()
+
+	return fn()
+}
+
+// write replaces the file atomically, readers never see partially written content
+func (f *FileCoordinator) write(path string, content []byte) error {
+	file, err := os.CreateTemp(f.directory, filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(content)
+	closeErr := file.Close()
+	if err == nil {
+		err = closeErr
+	}
+
+	if err == nil {
+		err = os.Rename(file.Name(), path)
+	}
+
+	if err != nil {
+		_ = os.Remove(file.Name())
+		return err
+	}
+
+	return nil
+}
+
+func (f *FileCoordinator) read(path string, value interface{}) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(content, value)
+}
+
+// NewFileCoordinator ...
+func NewFileCoordinator(directory string) *FileCoordinator {
+	return &FileCoordinator{
+		directory: directory,
+	}
+}
@@ -0,0 +1,173 @@
+package recovery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/oddin-gg/gosdk/protocols"
+)
+
+func expectAcquire(t *testing.T, coordinator protocols.RecoveryCoordinator, owner string, now time.Time, expected bool) {
+	t.Helper()
+
+	acquired, err := coordinator.AcquireRecovery(1, owner, now, time.Minute)
+	switch {
+	case err != nil:
+		t.Fatal(err)
+	case acquired != expected:
+		t.Fatalf("%s acquired lock %t, expected %t", owner, acquired, expected)
+	}
+}
+
+func TestCoordinator(t *testing.T) {
+	coordinators := map[string]protocols.RecoveryCoordinator{
+		"in memory": NewInMemoryCoordinator(),
+		"file":      NewFileCoordinator(t.TempDir()),
+	}
+
+	for name, coordinator := range coordinators {
+		t.Run(name, func(t *testing.T) {
+			now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+			expectAcquire(t, coordinator, "a", now, true)
+			expectAcquire(t, coordinator, "b", now, false)
+			expectAcquire(t, coordinator, "a", now.Add(30*time.Second), true)
+
+			// Lock was refreshed by the owner, it is taken over only once it expires
+			expectAcquire(t, coordinator, "b", now.Add(time.Minute), false)
+			now = now.Add(2 * time.Minute)
+			expectAcquire(t, coordinator, "b", now, true)
+			expectAcquire(t, coordinator, "a", now, false)
+
+			// Former owner can not release lock taken over by another owner
+			result := protocols.RecoveryResult{RequestID: 5, StartedAt: now, FinishedAt: now}
+			if err := coordinator.ReleaseRecovery(1, "a", &result); err != nil {
+				t.Fatal(err)
+			}
+			expectAcquire(t, coordinator, "a", now, false)
+
+			if err := coordinator.ReleaseRecovery(1, "b", nil); err != nil {
+				t.Fatal(err)
+			}
+			expectAcquire(t, coordinator, "a", now, true)
+
+			last, err := coordinator.LastRecovery(1)
+			switch {
+			case err != nil:
+				t.Fatal(err)
+			case last == nil || last.RequestID != result.RequestID || !last.FinishedAt.Equal(result.FinishedAt):
+				t.Fatalf("unexpected last recovery %v", last)
+			}
+
+			last, err = coordinator.LastRecovery(2)
+			if err != nil || last != nil {
+				t.Fatalf("unexpected last recovery of unknown producer %v, %v", last, err)
+			}
+		})
+	}
+}
+
+func openCoordinatedManagers(t *testing.T, nodeIDs ...int) (*testAPI, *testClock, []*Manager) {
+	api := newTestAPI(t)
+	coordinator := NewInMemoryCoordinator()
+	clock := &testClock{now: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)}
+
+	managers := make([]*Manager, 0, len(nodeIDs))
+	for _, nodeID := range nodeIDs {
+		cfg := newTestConfiguration(api)
+		cfg.clock = clock
+		cfg.nodeID = &nodeID
+		cfg.coordinator = coordinator
+
+		m, _, _ := openTestManager(t, cfg)
+		managers = append(managers, m)
+	}
+
+	return api, clock, managers
+}
+
+func receiveAlive(m *Manager, clock *testClock) {
+	now := clock.Now()
+	m.OnAliveReceived(1, protocols.MessageTimestamp{Created: now, Received: now}, true, protocols.SystemAliveOnly)
+}
+
+func expectRecoveries(t *testing.T, api *testAPI, expected int) []uint {
+	t.Helper()
+
+	recoveries := api.recoveries()
+	if len(recoveries) != expected {
+		t.Fatalf("expected %d recovery requests, got %v", expected, recoveries)
+	}
+
+	return recoveries
+}
+
+func expectProducerDown(t *testing.T, m *Manager, expected bool) {
+	t.Helper()
+
+	down, err := m.producerManager.IsProducerDown(1)
+	switch {
+	case err != nil:
+		t.Fatal(err)
+	case down != expected:
+		t.Fatalf("producer down %t, expected %t", down, expected)
+	}
+}
+
+func TestManagerWaitsForCoordinatedRecovery(t *testing.T) {
+	api, clock, managers := openCoordinatedManagers(t, 1, 1)
+	first, second := managers[0], managers[1]
+
+	receiveAlive(first, clock)
+	recoveries := expectRecoveries(t, api, 1)
+
+	receiveAlive(second, clock)
+	expectRecoveries(t, api, 1)
+
+	clock.advance(time.Second)
+	first.OnSnapshotCompleteReceived(1, recoveries[0], protocols.AllMessageInterest)
+	expectProducerDown(t, first, false)
+
+	// Result of the first node is reused without requesting recovery
+	receiveAlive(second, clock)
+	expectRecoveries(t, api, 1)
+	expectProducerDown(t, second, false)
+}
+
+func TestManagerRecoversAfterOtherNodeID(t *testing.T) {
+	api, clock, managers := openCoordinatedManagers(t, 1, 2)
+	first, second := managers[0], managers[1]
+
+	receiveAlive(first, clock)
+	recoveries := expectRecoveries(t, api, 1)
+
+	receiveAlive(second, clock)
+	expectRecoveries(t, api, 1)
+
+	clock.advance(time.Second)
+	first.OnSnapshotCompleteReceived(1, recoveries[0], protocols.AllMessageInterest)
+
+	// Recovery messages of the first node are not routed to the other node id, so it requests its own recovery
+	receiveAlive(second, clock)
+	expectRecoveries(t, api, 2)
+	expectProducerDown(t, second, true)
+}
+
+func TestManagerTakesOverExpiredRecovery(t *testing.T) {
+	api, clock, managers := openCoordinatedManagers(t, 1, 1)
+	first, second := managers[0], managers[1]
+
+	receiveAlive(first, clock)
+	expectRecoveries(t, api, 1)
+
+	receiveAlive(second, clock)
+	expectRecoveries(t, api, 1)
+
+	// First node never finished its recovery, lock expires after max recovery execution time
+	clock.advance(time.Duration(first.cfg.MaxRecoveryExecutionMinutes()+1) * time.Minute)
+	receiveAlive(second, clock)
+	recoveries := expectRecoveries(t, api, 2)
+
+	second.OnSnapshotCompleteReceived(1, recoveries[1], protocols.AllMessageInterest)
+	expectProducerDown(t, second, false)
+}
@@ -26,3 +26,7 @@ func (d DummyManager) OnAliveReceived(producerID uint, timestamp protocols.Messa
 // OnSnapshotCompleteReceived ...
 func (d DummyManager) OnSnapshotCompleteReceived(producerID uint, requestID uint, messageInterest protocols.MessageInterest) {
 }
+
+// OnEventMessageReceived ...
+func (d DummyManager) OnEventMessageReceived(producerID uint, eventID protocols.URN, sportID *protocols.URN) {
+}
@@ -0,0 +1,64 @@
+package recovery
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"sync"
+
+	"github.com/oddin-gg/gosdk/protocols"
+)
+
+// FileStateStore keeps recovery state in json file, file is replaced atomically on every save
+type FileStateStore struct {
+	path string
+	lock sync.Mutex
+}
+
+// Load returns empty state when file does not exist yet
+func (f *FileStateStore) Load() (map[uint]protocols.ProducerRecoveryState, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	content, err := os.ReadFile(f.path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return make(map[uint]protocols.ProducerRecoveryState), nil
+	case err != nil:
+		return nil, err
+	}
+
+	var states map[uint]protocols.ProducerRecoveryState
+	err = json.Unmarshal(content, &states)
+	if err != nil {
+		return nil, err
+	}
+
+	return states, nil
+}
+
+// Save ...
+func (f *FileStateStore) Save(states map[uint]protocols.ProducerRecoveryState) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	content, err := json.Marshal(states)
+	if err != nil {
+		return err
+	}
+
+	tmpPath := f.path + ".tmp"
+	err = os.WriteFile(tmpPath, content, 0o600)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, f.path)
+}
+
+// NewFileStateStore ...
+func NewFileStateStore(path string) *FileStateStore {
+	return &FileStateStore{
+		path: path,
+	}
+}
@@ -0,0 +1,16 @@
+//go:build !unix && !windows
+
+package recovery
+
+import (
+	"errors"
+	"os"
+)
+
+func lockFile(_ *os.File) error {
+	return errors.New("file locking is not supported on this platform")
+}
+
+func unlockFile(_ *os.File) error {
+	return nil
+}
@@ -0,0 +1,78 @@
+package recovery
+
+import (
+	"sync"
+
+	"github.com/oddin-gg/gosdk/protocols"
+)
+
+// history keeps the last entries in a ring buffer
+type history struct {
+	lock    sync.Mutex
+	entries []protocols.RecoveryHistoryEntry
+	next    int
+	full    bool
+}
+
+func (h *history) add(entry protocols.RecoveryHistoryEntry) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if len(h.entries) == 0 {
+		return
+	}
+
+	h.entries[h.next] = entry
+	h.next = (h.next + 1) % len(h.entries)
+	if h.next == 0 {
+		h.full = true
+	}
+}
+
+func (h *history) list(producerIDs []uint) []protocols.RecoveryHistoryEntry {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	ordered := make([]protocols.RecoveryHistoryEntry, 0, len(h.entries))
+	if h.full {
+		ordered = append(ordered, h.entries[h.next:]...)
+	}
+	ordered = append(ordered, h.entries[:h.next]...)
+
+	if len(producerIDs) == 0 {
+		return ordered
+	}
+
+	result := make([]protocols.RecoveryHistoryEntry, 0, len(ordered))
+	for _, entry := range ordered {
+		for _, producerID := range producerIDs {
+			if entry.ProducerID == producerID {
+				result = append(result, entry)
+				break
+			}
+		}
+	}
+
+	return result
+}
+
+func newHistory(size int) *history {
+	if size < 0 {
+		size = 0
+	}
+
+	return &history{
+		entries: make([]protocols.RecoveryHistoryEntry, size),
+	}
+}
+
+// record adds entry to the history and passes it to the sink
+func (m *Manager) record(entry protocols.RecoveryHistoryEntry) {
+	entry.Timestamp = m.cfg.Clock().Now()
+	m.history.add(entry)
+
+	sink := m.cfg.RecoveryHistorySink()
+	if sink != nil {
+		sink.Record(entry)
+	}
+}
@@ -1,6 +1,7 @@
 package recovery
 
 import (
+	"context"
 	"errors"
 	"math"
 	"sync"
@@ -8,29 +9,35 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/oddin-gg/gosdk/internal/api"
+	"github.com/oddin-gg/gosdk/internal/factory"
 	"github.com/oddin-gg/gosdk/internal/producer"
 	"github.com/oddin-gg/gosdk/protocols"
 	log "github.com/sirupsen/logrus"
 )
 
-const (
-	initialDelay = 60 * time.Second
-	tickPeriod   = 10 * time.Second
-)
-
 // Manager ...
 type Manager struct {
+	ctx                    context.Context
 	cfg                    protocols.OddsFeedConfiguration
 	producerManager        *producer.Manager
 	apiClient              *api.Client
 	lock                   sync.RWMutex
+	closed                 bool
 	producerRecoveryData   map[uint]*producerRecoveryData
 	logger                 *log.Entry
-	ticker                 *time.Ticker
-	closeCh                chan bool
+	closeCh                chan struct{}
 	messageProcessingTimes map[uuid.UUID]time.Time
 	msgCh                  chan protocols.RecoveryMessage
 	sequence               *generator
+	owner                  string
+	bulkRecoveries         map[uint]*bulkEventRecovery
+	history                *history
+	feedMessageFactory     *factory.FeedMessageFactory
+	activeEvents           *activeEvents
+	synthetic              *syntheticSender
+	// sendLock is held by senders to msgCh, msgCh is closed under it after closeCh
+	sendLock sync.RWMutex
+	wg       sync.WaitGroup
 }
 
 // OnMessageProcessingStarted ...
@@ -66,8 +73,8 @@ func (m *Manager) OnMessageProcessingEnded(sessionID uuid.UUID, producerID uint,
 	switch {
 	case start.IsZero():
 		m.logger.Warn("message processing ended, but was not started")
-	case time.Since(start).Milliseconds() > 1000:
-		m.logger.Warnf("processing message took more than 1s - %d ms", time.Since(start).Milliseconds())
+	case m.cfg.Clock().Now().Sub(start) > m.cfg.SlowProcessingThreshold():
+		m.logger.Warnf("processing message took more than %s - %d ms", m.cfg.SlowProcessingThreshold(), m.cfg.Clock().Now().Sub(start).Milliseconds())
 	}
 
 	m.lock.Lock()
@@ -84,13 +91,16 @@ func (m *Manager) OnAliveReceived(producerID uint, timestamp protocols.MessageTi
 		return
 
 	case messageInterest == protocols.SystemAliveOnly:
+		data.transition.Lock()
+		defer data.transition.Unlock()
+
 		err := m.systemSessionAliveReceived(timestamp, isSubscribed, data)
 		if err != nil {
 			m.logger.WithError(err).Error("failed to process alive message")
 		}
 
 	default:
-		data.lastUserSessionAliveReceivedTimestamp = timestamp.Created
+		data.userSessionAliveReceived(timestamp.Created)
 	}
 }
 
@@ -104,11 +114,20 @@ func (m *Manager) OnSnapshotCompleteReceived(producerID uint, requestID uint, me
 		return
 	}
 
+	data.transition.Lock()
+	defer data.transition.Unlock()
+
 	switch {
 	case data.isDisabled():
 		m.logger.Infof("received snapshot recovery complete for disabled producer %d", producerID)
 
 	case !data.isKnownRecovery(requestID):
+		// Event recovery of a bulk request is forgotten by the producer when it goes down, its events were recovered
+		if m.bulkEventRecoveryFinished(requestID, protocols.CompletedEventRecoveryStatus) {
+			m.logger.Infof("bulk event recovery finished for request %d and producer %d", requestID, producerID)
+			return
+		}
+
 		m.logger.Infof("unknown snapshot recovery complete received for request %d and producer %d", requestID, producerID)
 
 	case data.validateEventSnapshotComplete(requestID, messageInterest):
@@ -127,20 +146,57 @@ func (m *Manager) OnSnapshotCompleteReceived(producerID uint, requestID uint, me
 
 // InitiateEventOddsMessagesRecovery ...
 func (m *Manager) InitiateEventOddsMessagesRecovery(producerID uint, eventID protocols.URN) (uint, error) {
-	return m.makeEventRecovery(producerID, eventID, m.apiClient.PostEventOddsRecovery)
+	return m.makeEventRecovery(context.Background(), producerID, eventID, m.apiClient.PostEventOddsRecovery)
 }
 
 // InitiateEventStatefulMessagesRecovery ...
 func (m *Manager) InitiateEventStatefulMessagesRecovery(producerID uint, eventID protocols.URN) (uint, error) {
-	return m.makeEventRecovery(producerID, eventID, m.apiClient.PostEventStatefulRecovery)
+	return m.makeEventRecovery(context.Background(), producerID, eventID, m.apiClient.PostEventStatefulRecovery)
+}
+
+// InitiateBulkEventRecovery ...
+func (m *Manager) InitiateBulkEventRecovery(request protocols.BulkEventRecoveryRequest) (protocols.BulkEventRecovery, error) {
+	return m.makeBulkEventRecovery(context.Background(), request)
+}
+
+// Status ...
+func (m *Manager) Status() map[uint]protocols.ProducerRecoveryStatus {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	result := make(map[uint]protocols.ProducerRecoveryStatus, len(m.producerRecoveryData))
+	for producerID, data := range m.producerRecoveryData {
+		result[producerID] = data.status()
+	}
+
+	return result
+}
+
+// History ...
+func (m *Manager) History(producerIDs ...uint) []protocols.RecoveryHistoryEntry {
+	return m.history.list(producerIDs)
+}
+
+// WithContext returns manager which uses ctx for API calls
+func (m *Manager) WithContext(ctx context.Context) protocols.RecoveryManager {
+	return contextManager{
+		ctx:     ctx,
+		manager: m,
+	}
 }
 
 // Open ...
-func (m *Manager) Open() (<-chan protocols.RecoveryMessage, error) {
+func (m *Manager) Open(ctx context.Context) (<-chan protocols.RecoveryMessage, error) {
 	if m.msgCh != nil {
 		return nil, errors.New("already opened")
 	}
 
+	// Snapshot recoveries and synthetic messages use context of the feed
+	m.ctx = ctx
+	if m.feedMessageFactory != nil {
+		m.feedMessageFactory = m.feedMessageFactory.WithContext(ctx)
+	}
+
 	activeProducers, err := m.producerManager.ActiveProducers()
 	switch {
 	case err != nil:
@@ -149,27 +205,42 @@ func (m *Manager) Open() (<-chan protocols.RecoveryMessage, error) {
 		m.logger.Warn("no active producers")
 	}
 
+	producerIDs := make([]uint, 0, len(activeProducers))
 	m.lock.Lock()
 	m.producerRecoveryData = make(map[uint]*producerRecoveryData)
 	for id := range activeProducers {
 		m.producerRecoveryData[id] = newProducerRecoveryData(id, m.producerManager)
+		producerIDs = append(producerIDs, id)
 	}
 	m.lock.Unlock()
 
-	m.msgCh = make(chan protocols.RecoveryMessage)
-	m.closeCh = make(chan bool)
+	m.restoreState(producerIDs)
+
+	m.msgCh = make(chan protocols.RecoveryMessage, m.cfg.ChannelBufferSize())
+	m.closeCh = make(chan struct{})
+	if m.cfg.SyntheticSuspension() {
+		m.synthetic = newSyntheticSender()
+		m.synthetic.start(m.msgCh)
+	}
+
+	m.wg.Add(1)
 	go func() {
+		defer m.wg.Done()
+
 		select {
-		case <-time.After(initialDelay):
+		case <-m.cfg.Clock().After(m.cfg.RecoveryInitialDelay()):
 		case <-m.closeCh:
 			return
 		}
 
-		m.ticker = time.NewTicker(tickPeriod)
+		ticker := m.cfg.Clock().NewTicker(m.cfg.RecoveryTickPeriod())
+		defer ticker.Stop()
+
 		for {
 			select {
-			case <-m.ticker.C:
+			case <-ticker.C():
 				m.timerTick()
+				m.persistState()
 
 			case <-m.closeCh:
 				return
@@ -182,43 +253,98 @@ func (m *Manager) Open() (<-chan protocols.RecoveryMessage, error) {
 
 // Close ...
 func (m *Manager) Close() {
-	if m.ticker != nil {
-		m.ticker.Stop()
+	m.lock.Lock()
+	if m.closed || m.closeCh == nil {
+		m.lock.Unlock()
+		return
 	}
+	m.closed = true
+	m.lock.Unlock()
 
-	if m.closeCh != nil {
-		// make sure we timerTick is not running
-		m.closeCh <- true
+	// Sends to msgCh are interrupted, so closing does not depend on the reader of msgCh
+	close(m.closeCh)
+	m.wg.Wait()
+	m.persistState()
+	m.releaseRecoveries()
+
+	if m.synthetic != nil {
+		m.synthetic.close()
 	}
 
-	if m.msgCh != nil {
-		close(m.msgCh)
+	m.sendLock.Lock()
+	defer m.sendLock.Unlock()
+
+	close(m.msgCh)
+}
+
+func (m *Manager) isClosed() bool {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return m.closed
+}
+
+// send delivers msg unless the manager is closing, false is returned when msg was not delivered
+func (m *Manager) send(msg protocols.RecoveryMessage) bool {
+	m.sendLock.RLock()
+	defer m.sendLock.RUnlock()
+
+	select {
+	case <-m.closeCh:
+		return false
+	default:
+	}
+
+	select {
+	case m.msgCh <- msg:
+		return true
+	case <-m.closeCh:
+		return false
 	}
 }
 
-func (m *Manager) makeEventRecovery(producerID uint, eventID protocols.URN, callback func(string, protocols.URN, uint, *int) (bool, error)) (uint, error) {
-	now := time.Now()
+func (m *Manager) makeEventRecovery(
+	ctx context.Context,
+	producerID uint,
+	eventID protocols.URN,
+	callback func(context.Context, string, protocols.URN, uint, *int) (bool, error),
+) (uint, error) {
+	requestID := m.sequence.next()
+	_, err := m.requestEventRecovery(ctx, producerID, eventID, requestID, callback)
+	if err != nil {
+		return 0, err
+	}
+
+	return requestID, nil
+}
+
+func (m *Manager) requestEventRecovery(
+	ctx context.Context,
+	producerID uint,
+	eventID protocols.URN,
+	requestID uint,
+	callback func(context.Context, string, protocols.URN, uint, *int) (bool, error),
+) (bool, error) {
+	now := m.cfg.Clock().Now()
 	data := m.findOrMakeProducerRecoveryData(producerID)
 
 	producerName, err := data.producerName()
 	if err != nil {
-		return 0, err
+		return false, err
 	}
 
-	requestID := m.sequence.next()
-
 	data.setEventRecoveryState(eventID, requestID, now)
-	success, err := callback(producerName, eventID, requestID, m.cfg.SdkNodeID())
+	success, err := callback(ctx, producerName, eventID, requestID, m.cfg.SdkNodeID())
 	if !success {
 		data.eventRecoveryCompleted(requestID)
 	}
 
 	if err != nil {
 		m.logger.WithError(err).Error("event recovery failed")
-		return 0, err
+		return false, err
 	}
 
-	return requestID, nil
+	return success, nil
 }
 
 func (m *Manager) findOrMakeProducerRecoveryData(producerID uint) *producerRecoveryData {
@@ -244,33 +370,39 @@ func (m *Manager) timerTick() {
 	}
 	m.lock.RUnlock()
 
-	now := time.Now()
+	now := m.cfg.Clock().Now()
+	m.activeEvents.prune(now.Add(-activeEventTimeout))
 
 	for i := range localRecoveryData {
-		rd := localRecoveryData[i]
-		disabled := rd.isDisabled()
-		if disabled {
-			continue
+		err := m.checkProducer(localRecoveryData[i], now)
+		if err != nil {
+			m.logger.WithError(err).Errorf("failed to check recovery")
 		}
+	}
+}
 
-		var lastTimestamp time.Time
-		if rd.lastSystemAliveReceivedTimestamp != nil {
-			lastTimestamp = *rd.lastSystemAliveReceivedTimestamp
-		}
+func (m *Manager) checkProducer(data *producerRecoveryData, now time.Time) error {
+	data.transition.Lock()
+	defer data.transition.Unlock()
 
-		aliveInterval := now.Sub(lastTimestamp)
-		var err error
-		switch {
-		case aliveInterval.Seconds() > float64(m.cfg.MaxInactivitySeconds()):
-			err = m.producerDown(rd, protocols.AliveInternalViolationProducerDownReason)
-		case !m.calculateTiming(rd, now):
-			err = m.producerDown(rd, protocols.ProcessingQueueDelayViolationProducerDownReason)
-		}
+	if data.isDisabled() {
+		return nil
+	}
 
-		if err != nil {
-			m.logger.WithError(err).Errorf("failed to check recovery")
-		}
+	var lastTimestamp time.Time
+	if data.lastSystemAliveReceivedTimestamp != nil {
+		lastTimestamp = *data.lastSystemAliveReceivedTimestamp
+	}
+
+	aliveInterval := now.Sub(lastTimestamp)
+	switch {
+	case aliveInterval.Seconds() > float64(m.cfg.MaxInactivitySeconds()):
+		return m.producerDown(data, protocols.AliveInternalViolationProducerDownReason)
+	case !m.calculateTiming(data, now):
+		return m.producerDown(data, protocols.ProcessingQueueDelayViolationProducerDownReason)
 	}
+
+	return nil
 }
 
 func (m *Manager) calculateTiming(data *producerRecoveryData, now time.Time) bool {
@@ -281,7 +413,7 @@ func (m *Manager) calculateTiming(data *producerRecoveryData, now time.Time) boo
 		m.logger.WithError(err).Warn("failed to get last processed message gen timestamp")
 		return false
 	}
-	lastUserSessionAliveReceivedTimestamp := data.lastUserSessionAliveReceivedTimestamp
+	lastUserSessionAliveReceivedTimestamp := data.lastUserSessionAliveReceivedAt()
 
 	messageProcessingDelay := now.Sub(lastProcessedMessageGenTimestamp)
 	userAliveDelay := now.Sub(lastUserSessionAliveReceivedTimestamp)
@@ -290,7 +422,7 @@ func (m *Manager) calculateTiming(data *producerRecoveryData, now time.Time) boo
 }
 
 func (m *Manager) producerDown(data *producerRecoveryData, reason protocols.ProducerDownReason) error {
-	if data.isDisabled() {
+	if data.isDisabled() || data.isManuallyDown() && reason != protocols.ManualProducerDownReason {
 		return nil
 	}
 
@@ -309,6 +441,12 @@ func (m *Manager) producerDown(data *producerRecoveryData, reason protocols.Prod
 
 	if data.recoveryState == protocols.StartedRecoveryState && reason != protocols.ProcessingQueueDelayViolationProducerDownReason {
 		data.interruptProducerRecovery()
+		m.record(protocols.RecoveryHistoryEntry{
+			Type:       protocols.RecoveryInterruptedRecoveryHistoryEntryType,
+			ProducerID: data.producerID,
+			RequestID:  data.currentRecovery.recoveryID,
+			After:      data.recoveryAfter,
+		})
 	}
 
 	if !data.isFlaggedDown() {
@@ -333,7 +471,27 @@ func (m *Manager) notifyProducerChangedState(data *producerRecoveryData, reason
 		return err
 	}
 
-	now := time.Now()
+	now := m.cfg.Clock().Now()
+	down := data.isFlaggedDown()
+	entry := protocols.RecoveryHistoryEntry{
+		Type:       protocols.ProducerUpRecoveryHistoryEntryType,
+		ProducerID: data.producerID,
+		Reason:     reason,
+	}
+
+	switch {
+	case down:
+		entry.Type = protocols.ProducerDownRecoveryHistoryEntryType
+		if data.downSince.IsZero() {
+			data.downSince = now
+		}
+	case !data.downSince.IsZero():
+		entry.Duration = now.Sub(data.downSince)
+		data.downSince = time.Time{}
+	}
+
+	m.record(entry)
+
 	delayed := !m.calculateTiming(data, now)
 	msg := newProducerStatusImpl(
 		producerData,
@@ -343,13 +501,19 @@ func (m *Manager) notifyProducerChangedState(data *producerRecoveryData, reason
 			Received:  now,
 			Published: now,
 		},
-		data.isFlaggedDown(),
+		down,
 		delayed,
 		reason,
 	)
 
-	m.msgCh <- protocols.RecoveryMessage{
+	m.send(protocols.RecoveryMessage{
 		ProducerStatus: msg,
+	})
+
+	if down {
+		m.suspendEvents(data, producerData, reason, now)
+	} else {
+		m.resumeEvents(data, producerData, reason, now)
 	}
 
 	return nil
@@ -367,6 +531,10 @@ func (m *Manager) systemSessionAliveReceived(timestamp protocols.MessageTimestam
 		return err
 	}
 
+	if data.isManuallyDown() {
+		return data.systemAliveReceived(timestamp.Received, timestamp.Created)
+	}
+
 	if !subscribed {
 		if !data.isFlaggedDown() {
 			err := m.producerDown(data, protocols.OtherProducerDownReason)
@@ -378,7 +546,7 @@ func (m *Manager) systemSessionAliveReceived(timestamp protocols.MessageTimestam
 		return m.makeSnapshotRecovery(data, recoveryTimestamp)
 	}
 
-	now := time.Now()
+	now := m.cfg.Clock().Now()
 	isBackFromInactivity := data.isFlaggedDown() &&
 		!data.isPerformingRecovery() &&
 		data.producerDownReason == protocols.ProcessingQueueDelayViolationProducerDownReason &&
@@ -402,6 +570,14 @@ func (m *Manager) systemSessionAliveReceived(timestamp protocols.MessageTimestam
 		recoveryTiming := now.Sub(data.lastRecoveryStartedAt())
 		maxInterval := float64(m.cfg.MaxRecoveryExecutionMinutes())
 		if data.isPerformingRecovery() && recoveryTiming.Minutes() > maxInterval {
+			m.record(protocols.RecoveryHistoryEntry{
+				Type:       protocols.RecoveryFailedRecoveryHistoryEntryType,
+				ProducerID: data.producerID,
+				RequestID:  data.currentRecovery.recoveryID,
+				After:      data.recoveryAfter,
+				Duration:   recoveryTiming,
+				Error:      "recovery timed out",
+			})
 			data.setProducerRecoveryState(0, time.Time{}, protocols.ErrorRecoveryState)
 			err = m.makeSnapshotRecovery(data, recoveryTimestamp)
 			if err != nil {
@@ -424,9 +600,16 @@ func (m *Manager) snapshotRecoveryFinished(requestID uint, data *producerRecover
 	if started.IsZero() {
 		return errors.New("inconsistent recovery state")
 	}
-	finished := time.Now()
+	finished := m.cfg.Clock().Now()
 	m.logger.Infof("recovery finished for request %d in %d ms", requestID, finished.Sub(started).Milliseconds())
 
+	m.releaseRecovery(data, &protocols.RecoveryResult{
+		NodeID:     m.cfg.SdkNodeID(),
+		RequestID:  requestID,
+		StartedAt:  started,
+		FinishedAt: finished,
+	})
+
 	if data.recoveryState == protocols.InterruptedRecoveryState {
 		err := m.makeSnapshotRecovery(data, data.lastValidAliveGenTimestampInRecovery)
 		if err != nil {
@@ -434,6 +617,10 @@ func (m *Manager) snapshotRecoveryFinished(requestID uint, data *producerRecover
 		}
 	}
 
+	return m.completeSnapshotRecovery(data, requestID, started)
+}
+
+func (m *Manager) completeSnapshotRecovery(data *producerRecoveryData, requestID uint, started time.Time) error {
 	var reason protocols.ProducerUpReason
 	if data.firstRecoveryCompleted {
 		reason = protocols.ReturnedFromInactivityProducerUpReason
@@ -441,9 +628,15 @@ func (m *Manager) snapshotRecoveryFinished(requestID uint, data *producerRecover
 		reason = protocols.FirstRecoveryCompletedProducerUpReason
 	}
 
-	if !data.firstRecoveryCompleted {
-		data.firstRecoveryCompleted = true
-	}
+	data.setFirstRecoveryCompleted()
+
+	m.record(protocols.RecoveryHistoryEntry{
+		Type:       protocols.RecoveryCompletedRecoveryHistoryEntryType,
+		ProducerID: data.producerID,
+		RequestID:  requestID,
+		After:      data.recoveryAfter,
+		Duration:   m.cfg.Clock().Now().Sub(started),
+	})
 
 	data.setProducerRecoveryState(requestID, started, protocols.CompletedRecoveryState)
 	return m.producerUp(data, reason)
@@ -456,7 +649,7 @@ func (m *Manager) eventRecoveryFinished(id uint, data *producerRecoveryData) err
 	}
 
 	started := eventRecovery.recoveryStartedAt
-	finished := time.Now()
+	finished := m.cfg.Clock().Now()
 	m.logger.Infof("event %s recovery finished for request %d in %d ms", eventRecovery.eventID.ToString(), id, finished.Sub(started).Milliseconds())
 
 	producerData, err := m.producerManager.GetProducer(data.producerID)
@@ -464,7 +657,7 @@ func (m *Manager) eventRecoveryFinished(id uint, data *producerRecoveryData) err
 		return err
 	}
 
-	m.msgCh <- protocols.RecoveryMessage{
+	m.send(protocols.RecoveryMessage{
 		EventRecoveryMessage: &eventRecoveryMessageImpl{
 			eventID:   eventRecovery.eventID,
 			requestID: id,
@@ -476,18 +669,38 @@ func (m *Manager) eventRecoveryFinished(id uint, data *producerRecoveryData) err
 				Published: finished,
 			},
 		},
-	}
+	})
 
 	data.eventRecoveryCompleted(id)
+	m.bulkEventRecoveryFinished(id, protocols.CompletedEventRecoveryStatus)
 	return nil
 }
 
 func (m *Manager) makeSnapshotRecovery(data *producerRecoveryData, timestamp time.Time) error {
-	if m.msgCh == nil {
-		return nil
+	request, err := m.startSnapshotRecovery(data, timestamp)
+	if err != nil || request == nil {
+		return err
+	}
+
+	success, err := m.postSnapshotRecovery(m.ctx, request)
+	return m.recordSnapshotRequest(data, request, success, err)
+}
+
+// snapshotRequest is snapshot recovery which was started but not requested from API yet
+type snapshotRequest struct {
+	requestID    uint
+	producerName string
+	after        time.Time
+	startedAt    time.Time
+}
+
+// startSnapshotRecovery moves the producer to started recovery, nil is returned when no recovery was started
+func (m *Manager) startSnapshotRecovery(data *producerRecoveryData, timestamp time.Time) (*snapshotRequest, error) {
+	if m.msgCh == nil || m.isClosed() {
+		return nil, nil
 	}
 
-	now := time.Now()
+	now := m.cfg.Clock().Now()
 	recoverFrom := timestamp
 	if !timestamp.IsZero() {
 		recoveryTime := now.Sub(recoverFrom)
@@ -496,30 +709,84 @@ func (m *Manager) makeSnapshotRecovery(data *producerRecoveryData, timestamp tim
 		}
 	}
 
-	requestID := m.sequence.next()
 	producerName, err := data.producerName()
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	recovered, err := m.recoveredByOtherNode(data)
+	if err != nil || recovered {
+		return nil, err
 	}
 
+	acquired, err := m.acquireRecovery(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if !acquired {
+		m.waitForRecovery(data)
+		return nil, nil
+	}
+
+	data.recoveryWaitStartedAt = time.Time{}
+	requestID := m.sequence.next()
 	data.setProducerRecoveryState(requestID, now, protocols.StartedRecoveryState)
+	data.recoveryAfter = recoverFrom
 
 	m.logger.Infof("recovery started for request %d", requestID)
+	m.record(protocols.RecoveryHistoryEntry{
+		Type:       protocols.RecoveryStartedRecoveryHistoryEntryType,
+		ProducerID: data.producerID,
+		RequestID:  requestID,
+		After:      recoverFrom,
+	})
+
+	return &snapshotRequest{
+		requestID:    requestID,
+		producerName: producerName,
+		after:        recoverFrom,
+		startedAt:    now,
+	}, nil
+}
 
-	success, err := m.apiClient.PostRecovery(
-		producerName,
-		requestID,
+func (m *Manager) postSnapshotRecovery(ctx context.Context, request *snapshotRequest) (bool, error) {
+	return m.apiClient.PostRecovery(
+		ctx,
+		request.producerName,
+		request.requestID,
 		m.cfg.SdkNodeID(),
-		recoverFrom,
+		request.after,
 	)
+}
+
+// recordSnapshotRequest records result of the API request, the lock is released when the request failed
+func (m *Manager) recordSnapshotRequest(data *producerRecoveryData, request *snapshotRequest, success bool, err error) error {
 	if err != nil {
+		m.releaseRecovery(data, nil)
+		m.recordRecoveryFailed(data, request.requestID, request.after, err.Error())
 		return err
 	}
 
-	recoveryInfo := newRecoveryInfoImpl(recoverFrom, now, requestID, success, m.cfg.SdkNodeID())
+	if !success {
+		m.releaseRecovery(data, nil)
+		m.recordRecoveryFailed(data, request.requestID, request.after, "recovery request was not accepted")
+	}
+
+	recoveryInfo := newRecoveryInfoImpl(request.after, request.startedAt, request.requestID, success, m.cfg.SdkNodeID())
 	return m.producerManager.SetProducerRecoveryInfo(data.producerID, recoveryInfo)
 }
 
+func (m *Manager) recordRecoveryFailed(data *producerRecoveryData, requestID uint, after time.Time, reason string) {
+	m.record(protocols.RecoveryHistoryEntry{
+		Type:       protocols.RecoveryFailedRecoveryHistoryEntryType,
+		ProducerID: data.producerID,
+		RequestID:  requestID,
+		After:      after,
+		Error:      reason,
+	})
+}
+
 func (m *Manager) producerUp(data *producerRecoveryData, reason protocols.ProducerUpReason) error {
 	if data.isDisabled() {
 		return nil
@@ -536,14 +803,26 @@ func (m *Manager) producerUp(data *producerRecoveryData, reason protocols.Produc
 }
 
 // NewManager ...
-func NewManager(cfg protocols.OddsFeedConfiguration, producerManager *producer.Manager, apiClient *api.Client, logger *log.Entry) *Manager {
+func NewManager(
+	cfg protocols.OddsFeedConfiguration,
+	producerManager *producer.Manager,
+	apiClient *api.Client,
+	feedMessageFactory *factory.FeedMessageFactory,
+	logger *log.Entry,
+) *Manager {
 	return &Manager{
+		ctx:                    context.Background(),
 		cfg:                    cfg,
 		producerManager:        producerManager,
 		apiClient:              apiClient,
 		logger:                 logger,
 		messageProcessingTimes: make(map[uuid.UUID]time.Time),
 		sequence:               newGenerator(1),
+		owner:                  uuid.NewString(),
+		bulkRecoveries:         make(map[uint]*bulkEventRecovery),
+		history:                newHistory(cfg.RecoveryHistorySize()),
+		feedMessageFactory:     feedMessageFactory,
+		activeEvents:           newActiveEvents(),
 		producerRecoveryData:   make(map[uint]*producerRecoveryData),
 	}
 }
@@ -570,3 +849,44 @@ func (e eventRecoveryMessageImpl) EventID() protocols.URN {
 func (e eventRecoveryMessageImpl) RequestID() uint {
 	return e.requestID
 }
+
+type contextManager struct {
+	ctx     context.Context
+	manager *Manager
+}
+
+func (c contextManager) InitiateEventOddsMessagesRecovery(producerID uint, eventID protocols.URN) (uint, error) {
+	return c.manager.makeEventRecovery(c.ctx, producerID, eventID, c.manager.apiClient.PostEventOddsRecovery)
+}
+
+func (c contextManager) InitiateEventStatefulMessagesRecovery(producerID uint, eventID protocols.URN) (uint, error) {
+	return c.manager.makeEventRecovery(c.ctx, producerID, eventID, c.manager.apiClient.PostEventStatefulRecovery)
+}
+
+func (c contextManager) InitiateBulkEventRecovery(request protocols.BulkEventRecoveryRequest) (protocols.BulkEventRecovery, error) {
+	return c.manager.makeBulkEventRecovery(c.ctx, request)
+}
+
+func (c contextManager) Status() map[uint]protocols.ProducerRecoveryStatus {
+	return c.manager.Status()
+}
+
+func (c contextManager) History(producerIDs ...uint) []protocols.RecoveryHistoryEntry {
+	return c.manager.History(producerIDs...)
+}
+
+func (c contextManager) InitiateSnapshotRecovery(producerID uint, after time.Time) (uint, error) {
+	return c.manager.makeManualSnapshotRecovery(c.ctx, producerID, after)
+}
+
+func (c contextManager) CancelSnapshotRecovery(producerID uint) error {
+	return c.manager.CancelSnapshotRecovery(producerID)
+}
+
+func (c contextManager) MarkProducerDown(producerID uint) error {
+	return c.manager.MarkProducerDown(producerID)
+}
+
+func (c contextManager) WithContext(ctx context.Context) protocols.RecoveryManager {
+	return c.manager.WithContext(ctx)
+}
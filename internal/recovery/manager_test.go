@@ -0,0 +1,278 @@
+package recovery
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/oddin-gg/gosdk/internal/api"
+	"github.com/oddin-gg/gosdk/internal/producer"
+	"github.com/oddin-gg/gosdk/protocols"
+	log "github.com/sirupsen/logrus"
+)
+
+// testClock is moved only by tests, its timers never fire so tests drive the manager directly
+type testClock struct {
+	lock sync.Mutex
+	now  time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.now
+}
+
+func (c *testClock) advance(d time.Duration) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+func (c *testClock) After(time.Duration) <-chan time.Time {
+	return nil
+}
+
+func (c *testClock) NewTicker(time.Duration) protocols.Ticker {
+	return testTicker{}
+}
+
+type testTicker struct{}
+
+func (testTicker) C() <-chan time.Time {
+	return nil
+}
+
+func (testTicker) Stop() {}
+
+type testConfiguration struct {
+	protocols.OddsFeedConfiguration
+	apiURL      string
+	accessToken string
+	clock       *testClock
+	nodeID      *int
+	stateStore  protocols.RecoveryStateStore
+	coordinator protocols.RecoveryCoordinator
+}
+
+func (c *testConfiguration) APIURL() (string, error) {
+	return c.apiURL, nil
+}
+
+func (c *testConfiguration) AccessToken() *string {
+	return &c.accessToken
+}
+
+func (c *testConfiguration) APITimeout() time.Duration {
+	return 5 * time.Second
+}
+
+func (c *testConfiguration) ChannelBufferSize() int {
+	return 100
+}
+
+func (c *testConfiguration) Clock() protocols.Clock {
+	return c.clock
+}
+
+func (c *testConfiguration) SdkNodeID() *int {
+	return c.nodeID
+}
+
+func (c *testConfiguration) MaxInactivitySeconds() int {
+	return 20
+}
+
+func (c *testConfiguration) MaxRecoveryExecutionMinutes() int {
+	return 360
+}
+
+func (c *testConfiguration) RecoveryInitialDelay() time.Duration {
+	return time.Minute
+}
+
+func (c *testConfiguration) RecoveryTickPeriod() time.Duration {
+	return 10 * time.Second
+}
+
+func (c *testConfiguration) RecoveryHistorySize() int {
+	return 100
+}
+
+func (c *testConfiguration) RecoveryHistorySink() protocols.RecoveryHistorySink {
+	return nil
+}
+
+func (c *testConfiguration) SlowProcessingThreshold() time.Duration {
+	return time.Second
+}
+
+func (c *testConfiguration) SyntheticSuspension() bool {
+	return false
+}
+
+func (c *testConfiguration) RecoveryStateStore() protocols.RecoveryStateStore {
+	return c.stateStore
+}
+
+func (c *testConfiguration) RecoveryCoordinator() protocols.RecoveryCoordinator {
+	return c.coordinator
+}
+
+// testAPI serves producers and records requested snapshot recoveries
+type testAPI struct {
+	lock       sync.Mutex
+	server     *httptest.Server
+	requestIDs []uint
+	// hold delays responses to recovery requests until it is closed
+	hold chan struct{}
+}
+
+func (a *testAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.URL.Path == "/v1/descriptions/producers":
+		_, _ = fmt.Fprint(w, `<producers response_code="OK">`+
+			`<producer id="1" name="live" active="true" scope="live" stateful_recovery_window_in_minutes="60"/>`+
+			`<producer id="2" name="pre" active="true" scope="prematch" stateful_recovery_window_in_minutes="60"/>`+
+			`</producers>`)
+	case strings.HasSuffix(r.URL.Path, "/recovery/initiate_request"):
+		requestID, err := strconv.ParseUint(r.URL.Query().Get("request_id"), 10, 64)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		a.lock.Lock()
+		a.requestIDs = append(a.requestIDs, uint(requestID))
+		hold := a.hold
+		a.lock.Unlock()
+
+		if hold != nil {
+			<-hold
+		}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (a *testAPI) recoveries() []uint {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	return append([]uint(nil), a.requestIDs...)
+}
+
+// newTestAPI starts the server, API client uses the default transport so it is replaced to trust the test certificate
+func newTestAPI(t *testing.T) *testAPI {
+	a := &testAPI{}
+	a.server = httptest.NewTLSServer(a)
+
+	transport := http.DefaultTransport
+	http.DefaultTransport = a.server.Client().Transport
+	t.Cleanup(func() {
+		http.DefaultTransport = transport
+		a.server.Close()
+	})
+
+	return a
+}
+
+func newTestConfiguration(a *testAPI) *testConfiguration {
+	nodeID := 1
+	return &testConfiguration{
+		apiURL:      strings.TrimPrefix(a.server.URL, "https://"),
+		accessToken: "token",
+		clock:       &testClock{now: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)},
+		nodeID:      &nodeID,
+	}
+}
+
+// openTestManager opens manager with its own producer manager, manager is closed with the test
+func openTestManager(t *testing.T, cfg *testConfiguration) (*Manager, *producer.Manager, <-chan protocols.RecoveryMessage) {
+	t.Helper()
+
+	logger := log.NewEntry(log.New())
+	apiClient := api.New(cfg)
+	producerManager := producer.NewManager(cfg, apiClient, logger)
+	m := NewManager(cfg, producerManager, apiClient, nil, logger)
+
+	msgCh, err := m.Open(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(m.Close)
+
+	return m, producerManager, msgCh
+}
+
+// expectProducerStatus checks the next status of producer 1, statuses of other producers are skipped
+func expectProducerStatus(t *testing.T, msgCh <-chan protocols.RecoveryMessage, down bool, reason protocols.ProducerStatusReason) {
+	t.Helper()
+
+	for {
+		select {
+		case msg := <-msgCh:
+			status := msg.ProducerStatus
+			switch {
+			case status == nil:
+				t.Fatalf("expected producer status, got %+v", msg)
+			case status.Producer().ID() != 1:
+				continue
+			case status.IsDown() != down, status.ProducerStatusReason() != reason:
+				t.Fatalf("expected producer down %t with reason %d, got down %t with reason %d",
+					down, reason, status.IsDown(), status.ProducerStatusReason())
+			}
+			return
+		default:
+			t.Fatal("producer status was not sent")
+		}
+	}
+}
+
+// receiveSessionAlive processes alive as user session does, so messages are not considered delayed
+func receiveSessionAlive(m *Manager, clock *testClock) {
+	now := clock.Now()
+	sessionID := uuid.New()
+	m.OnMessageProcessingStarted(sessionID, 1, now)
+	m.OnAliveReceived(1, protocols.MessageTimestamp{Created: now, Received: now}, true, protocols.LiveOnlyMessageInterest)
+	m.OnMessageProcessingEnded(sessionID, 1, now)
+}
+
+func TestManagerProducerDownAndUp(t *testing.T) {
+	api := newTestAPI(t)
+	cfg := newTestConfiguration(api)
+	m, _, msgCh := openTestManager(t, cfg)
+
+	receiveAlive(m, cfg.clock)
+	recoveries := expectRecoveries(t, api, 1)
+	m.OnSnapshotCompleteReceived(1, recoveries[0], protocols.AllMessageInterest)
+	expectProducerStatus(t, msgCh, false, protocols.FirstRecoveryCompletedProducerStatusReason)
+
+	// Alive within max inactivity keeps the producer up
+	cfg.clock.advance(10 * time.Second)
+	receiveAlive(m, cfg.clock)
+	receiveSessionAlive(m, cfg.clock)
+	m.timerTick()
+	expectProducerDown(t, m, false)
+
+	cfg.clock.advance(time.Duration(cfg.MaxInactivitySeconds()+1) * time.Second)
+	m.timerTick()
+	expectProducerDown(t, m, true)
+	expectProducerStatus(t, msgCh, true, protocols.AliveIntervalViolationProducerStatusReason)
+
+	// Producer is recovered from the last alive once alives arrive again
+	receiveAlive(m, cfg.clock)
+	recoveries = expectRecoveries(t, api, 2)
+	m.OnSnapshotCompleteReceived(1, recoveries[1], protocols.AllMessageInterest)
+	expectProducerDown(t, m, false)
+	expectProducerStatus(t, msgCh, false, protocols.ReturnedFromInactivityProducerStatusReason)
+}
@@ -0,0 +1,151 @@
+package recovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/oddin-gg/gosdk/protocols"
+)
+
+// InitiateSnapshotRecovery ...
+func (m *Manager) InitiateSnapshotRecovery(producerID uint, after time.Time) (uint, error) {
+	return m.makeManualSnapshotRecovery(context.Background(), producerID, after)
+}
+
+// CancelSnapshotRecovery ...
+func (m *Manager) CancelSnapshotRecovery(producerID uint) error {
+	data, err := m.lockProducerRecoveryData(producerID)
+	if err != nil {
+		return err
+	}
+	defer data.transition.Unlock()
+
+	if !data.isPerformingRecovery() {
+		return fmt.Errorf("no recovery in progress for producer %d", producerID)
+	}
+
+	m.cancelSnapshotRecovery(data, "recovery cancelled")
+
+	// Producer is parked, otherwise the next alive would request a new recovery
+	return m.producerDown(data, protocols.ManualProducerDownReason)
+}
+
+// MarkProducerDown ...
+func (m *Manager) MarkProducerDown(producerID uint) error {
+	data, err := m.lockProducerRecoveryData(producerID)
+	if err != nil {
+		return err
+	}
+	defer data.transition.Unlock()
+
+	if data.isDisabled() {
+		return fmt.Errorf("producer %d is disabled", producerID)
+	}
+
+	if data.isPerformingRecovery() {
+		m.cancelSnapshotRecovery(data, "producer marked down")
+	}
+
+	return m.producerDown(data, protocols.ManualProducerDownReason)
+}
+
+func (m *Manager) makeManualSnapshotRecovery(ctx context.Context, producerID uint, after time.Time) (uint, error) {
+	data, err := m.lockProducerRecoveryData(producerID)
+	if err != nil {
+		return 0, err
+	}
+
+	request, err := m.startManualSnapshotRecovery(data, after)
+	data.transition.Unlock()
+
+	switch {
+	case err != nil:
+		return 0, err
+	case request == nil:
+		return 0, fmt.Errorf("recovery of producer %d is performed by another node", producerID)
+	}
+
+	// API is called without the transition lock, so alives and snapshot completes of the producer are not blocked
+	success, err := m.postSnapshotRecovery(ctx, request)
+
+	data.transition.Lock()
+	defer data.transition.Unlock()
+
+	// Recovery could be cancelled or replaced by another one meanwhile, their state is kept
+	if data.isKnownRecovery(request.requestID) {
+		err = m.recordSnapshotRequest(data, request, success, err)
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	return request.requestID, nil
+}
+
+// startManualSnapshotRecovery has to be called with transition lock held
+func (m *Manager) startManualSnapshotRecovery(data *producerRecoveryData, after time.Time) (*snapshotRequest, error) {
+	if data.isDisabled() {
+		return nil, fmt.Errorf("producer %d is disabled", data.producerID)
+	}
+
+	if data.isPerformingRecovery() {
+		m.cancelSnapshotRecovery(data, "recovery requested manually")
+	}
+
+	// Manual down reason is replaced, so the producer goes up once the recovery completes
+	if data.isManuallyDown() {
+		err := data.setProducerDown(protocols.OtherProducerDownReason)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err := m.producerDown(data, protocols.OtherProducerDownReason)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.startSnapshotRecovery(data, after)
+}
+
+func (m *Manager) cancelSnapshotRecovery(data *producerRecoveryData, reason string) {
+	m.logger.Infof("recovery cancelled for request %d", data.currentRecovery.recoveryID)
+	m.record(protocols.RecoveryHistoryEntry{
+		Type:       protocols.RecoveryInterruptedRecoveryHistoryEntryType,
+		ProducerID: data.producerID,
+		RequestID:  data.currentRecovery.recoveryID,
+		After:      data.recoveryAfter,
+		Error:      reason,
+	})
+
+	m.releaseRecovery(data, nil)
+	data.setProducerRecoveryState(0, time.Time{}, protocols.ErrorRecoveryState)
+}
+
+// lockProducerRecoveryData returns recovery data of the producer with transition lock held
+func (m *Manager) lockProducerRecoveryData(producerID uint) (*producerRecoveryData, error) {
+	if m.msgCh == nil {
+		return nil, errors.New("recovery manager is not opened")
+	}
+
+	m.lock.RLock()
+	data, ok := m.producerRecoveryData[producerID]
+	m.lock.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("unknown producer %d", producerID)
+	}
+
+	data.transition.Lock()
+
+	// Checked with transition lock held, Close releases recoveries under the same lock
+	if m.isClosed() {
+		data.transition.Unlock()
+		return nil, errors.New("recovery manager is closed")
+	}
+
+	return data, nil
+}
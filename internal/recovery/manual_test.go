@@ -0,0 +1,108 @@
+package recovery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/oddin-gg/gosdk/protocols"
+)
+
+func TestManualRecovery(t *testing.T) {
+	api := newTestAPI(t)
+	cfg := newTestConfiguration(api)
+	m, _, _ := openTestManager(t, cfg)
+
+	receiveAlive(m, cfg.clock)
+	recoveries := expectRecoveries(t, api, 1)
+	m.OnSnapshotCompleteReceived(1, recoveries[0], protocols.AllMessageInterest)
+	expectProducerDown(t, m, false)
+
+	requestID, err := m.InitiateSnapshotRecovery(1, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if recoveries = expectRecoveries(t, api, 2); recoveries[1] != requestID {
+		t.Fatalf("expected request %d, got %d", requestID, recoveries[1])
+	}
+	expectProducerDown(t, m, true)
+
+	// Cancelled producer is parked until recovery is initiated again
+	if err := m.CancelSnapshotRecovery(1); err != nil {
+		t.Fatal(err)
+	}
+	receiveAlive(m, cfg.clock)
+	expectRecoveries(t, api, 2)
+	expectProducerDown(t, m, true)
+
+	requestID, err = m.InitiateSnapshotRecovery(1, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.OnSnapshotCompleteReceived(1, requestID, protocols.AllMessageInterest)
+	expectProducerDown(t, m, false)
+
+	m.Close()
+	m.Close()
+
+	if _, err := m.InitiateSnapshotRecovery(1, time.Time{}); err == nil {
+		t.Fatal("recovery initiated on closed manager")
+	}
+	if err := m.MarkProducerDown(1); err == nil {
+		t.Fatal("producer marked down on closed manager")
+	}
+	if err := m.CancelSnapshotRecovery(1); err == nil {
+		t.Fatal("recovery cancelled on closed manager")
+	}
+}
+
+func TestManualRecoveryRequestDoesNotBlockProducer(t *testing.T) {
+	api := newTestAPI(t)
+	cfg := newTestConfiguration(api)
+	m, _, _ := openTestManager(t, cfg)
+
+	hold := make(chan struct{})
+	api.hold = hold
+
+	type result struct {
+		requestID uint
+		err       error
+	}
+	initiated := make(chan result, 1)
+	go func() {
+		requestID, err := m.InitiateSnapshotRecovery(1, time.Time{})
+		initiated <- result{requestID: requestID, err: err}
+	}()
+
+	for len(api.recoveries()) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// Alive and cancel are processed while the recovery request is in flight
+	processed := make(chan error, 1)
+	go func() {
+		receiveAlive(m, cfg.clock)
+		processed <- m.CancelSnapshotRecovery(1)
+	}()
+
+	select {
+	case err := <-processed:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(time.Second):
+		close(hold)
+		t.Fatal("producer is blocked by recovery request")
+	}
+
+	close(hold)
+	res := <-initiated
+	if res.err != nil || res.requestID != api.recoveries()[0] {
+		t.Fatalf("unexpected result of recovery request %d - %v", res.requestID, res.err)
+	}
+
+	// Cancel made while the request was in flight is kept
+	status := m.Status()[1]
+	if status.RecoveryState != protocols.ErrorRecoveryState || status.ProducerDownReason != protocols.ManualProducerDownReason {
+		t.Fatalf("cancelled recovery was overwritten - state %d, down reason %d", status.RecoveryState, status.ProducerDownReason)
+	}
+}
@@ -3,6 +3,7 @@ package recovery
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -14,6 +15,9 @@ type producerRecoveryData struct {
 	producerID      uint
 	producerManager *producer.Manager
 
+	// transition serializes state changes made by alive, snapshot complete, timer and manual requests
+	transition sync.Mutex
+	// lock guards fields read by status and event recoveries
 	lock sync.RWMutex
 
 	currentRecovery *recoveryData
@@ -26,6 +30,10 @@ type producerRecoveryData struct {
 	lastSystemAliveReceivedTimestamp *time.Time
 
 	firstRecoveryCompleted bool
+	recoveryWaitStartedAt  time.Time
+	recoveryAfter          time.Time
+	downSince              time.Time
+	suspendedEvents        []activeEvent
 
 	producerDownReason   protocols.ProducerDownReason
 	producerStatusReason protocols.ProducerStatusReason
@@ -48,6 +56,10 @@ func (p *producerRecoveryData) isFlaggedDown() bool {
 	return down
 }
 
+func (p *producerRecoveryData) isManuallyDown() bool {
+	return p.producerDownReason == protocols.ManualProducerDownReason && p.isFlaggedDown()
+}
+
 func (p *producerRecoveryData) isDisabled() bool {
 	enabled, err := p.producerManager.IsProducerEnabled(p.producerID)
 	if err != nil {
@@ -65,7 +77,13 @@ func (p *producerRecoveryData) eventRecoveryCompleted(recoveryID uint) {
 }
 
 func (p *producerRecoveryData) systemAliveReceived(receivedTimestamp time.Time, aliveGenTimestamp time.Time) error {
+	p.lock.Lock()
 	p.lastSystemAliveReceivedTimestamp = &receivedTimestamp
+	if p.recoveryState == protocols.StartedRecoveryState {
+		p.lastValidAliveGenTimestampInRecovery = aliveGenTimestamp
+	}
+	p.lock.Unlock()
+
 	flaggedDown := p.isFlaggedDown()
 	if !flaggedDown {
 		err := p.producerManager.SetLastAliveReceivedGenTimestamp(p.producerID, aliveGenTimestamp)
@@ -74,13 +92,23 @@ func (p *producerRecoveryData) systemAliveReceived(receivedTimestamp time.Time,
 		}
 	}
 
-	if p.recoveryState == protocols.StartedRecoveryState {
-		p.lastValidAliveGenTimestampInRecovery = aliveGenTimestamp
-	}
-
 	return nil
 }
 
+func (p *producerRecoveryData) userSessionAliveReceived(timestamp time.Time) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.lastUserSessionAliveReceivedTimestamp = timestamp
+}
+
+func (p *producerRecoveryData) lastUserSessionAliveReceivedAt() time.Time {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	return p.lastUserSessionAliveReceivedTimestamp
+}
+
 func (p *producerRecoveryData) validateSnapshotComplete(recoveryID uint, messageInterest protocols.MessageInterest) bool {
 	switch {
 	case !p.isPerformingRecovery():
@@ -223,6 +251,9 @@ func (p *producerRecoveryData) producerName() (string, error) {
 }
 
 func (p *producerRecoveryData) setProducerRecoveryState(recoveryID uint, recoveryStatedAt time.Time, recoveryState protocols.RecoveryState) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
 	p.recoveryState = recoveryState
 
 	recoveryData := newRecoveryData(recoveryID, recoveryStatedAt)
@@ -230,6 +261,9 @@ func (p *producerRecoveryData) setProducerRecoveryState(recoveryID uint, recover
 }
 
 func (p *producerRecoveryData) interruptProducerRecovery() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
 	p.recoveryState = protocols.InterruptedRecoveryState
 }
 
@@ -238,6 +272,9 @@ func (p *producerRecoveryData) setProducerDown(reason protocols.ProducerDownReas
 		return err
 	}
 
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
 	p.producerDownReason = reason
 	p.eventRecoveries = make(map[uint]*eventRecovery)
 
@@ -248,10 +285,21 @@ func (p *producerRecoveryData) setProducerUp() error {
 	if err := p.producerManager.SetProducerDown(p.producerID, false); err != nil {
 		return err
 	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
 	p.producerDownReason = protocols.DefaultProducerDownReason
 	return nil
 }
 
+func (p *producerRecoveryData) setFirstRecoveryCompleted() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.firstRecoveryCompleted = true
+}
+
 func (p *producerRecoveryData) setEventRecoveryState(eventID protocols.URN, recoveryID uint, recoveryStartedAt time.Time) {
 	p.lock.Lock()
 	defer p.lock.Unlock()
@@ -265,6 +313,46 @@ func (p *producerRecoveryData) setEventRecoveryState(eventID protocols.URN, reco
 	}
 }
 
+func (p *producerRecoveryData) status() protocols.ProducerRecoveryStatus {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	status := protocols.ProducerRecoveryStatus{
+		ProducerID:                     p.producerID,
+		RecoveryState:                  p.recoveryState,
+		RecoveryStartedAt:              p.lastRecoveryStartedAt(),
+		ProducerDownReason:             p.producerDownReason,
+		LastUserSessionAliveReceivedAt: p.lastUserSessionAliveReceivedTimestamp,
+		PendingEventRecoveries:         make([]protocols.PendingEventRecovery, 0, len(p.eventRecoveries)),
+		FirstRecoveryCompleted:         p.firstRecoveryCompleted,
+	}
+
+	if p.currentRecovery != nil {
+		status.RecoveryRequestID = p.currentRecovery.recoveryID
+	}
+
+	if p.lastSystemAliveReceivedTimestamp != nil {
+		status.LastSystemAliveReceivedAt = *p.lastSystemAliveReceivedTimestamp
+	}
+
+	// Producer which is not known to the producer manager has no processed messages
+	status.LastProcessedMessageGenTimestamp, _ = p.lastProcessedMessageGenTimestamp()
+
+	for _, er := range p.eventRecoveries {
+		status.PendingEventRecoveries = append(status.PendingEventRecoveries, protocols.PendingEventRecovery{
+			EventID:   er.eventID,
+			RequestID: er.recoveryID,
+			StartedAt: er.recoveryStartedAt,
+		})
+	}
+
+	sort.Slice(status.PendingEventRecoveries, func(i, j int) bool {
+		return status.PendingEventRecoveries[i].RequestID < status.PendingEventRecoveries[j].RequestID
+	})
+
+	return status
+}
+
 func newProducerRecoveryData(producerID uint, producerManager *producer.Manager) *producerRecoveryData {
 	return &producerRecoveryData{
 		producerID:      producerID,
@@ -0,0 +1,107 @@
+package recovery
+
+import (
+	"github.com/oddin-gg/gosdk/protocols"
+)
+
+// restoreState sets recovery timestamps of producers from the store, timestamps set by the user are kept
+func (m *Manager) restoreState(producerIDs []uint) {
+	store := m.cfg.RecoveryStateStore()
+	if store == nil {
+		return
+	}
+
+	states, err := store.Load()
+	if err != nil {
+		m.logger.WithError(err).Error("failed to load recovery state")
+		return
+	}
+
+	for _, producerID := range producerIDs {
+		state, ok := states[producerID]
+		if !ok {
+			continue
+		}
+
+		producer, err := m.producerManager.GetProducer(producerID)
+		if err != nil {
+			m.logger.WithError(err).Errorf("failed to restore recovery state of producer %d", producerID)
+			continue
+		}
+
+		if !state.LastProcessedMessageGenTimestamp.IsZero() {
+			err = m.producerManager.SetLastProcessedMessageGenTimestamp(producerID, state.LastProcessedMessageGenTimestamp)
+			if err != nil {
+				m.logger.WithError(err).Errorf("failed to restore last processed timestamp of producer %d", producerID)
+			}
+		}
+
+		if state.LastRecoveryInfo != nil {
+			info := state.LastRecoveryInfo
+			err = m.producerManager.SetProducerRecoveryInfo(
+				producerID,
+				newRecoveryInfoImpl(info.After, info.Timestamp, info.RequestID, info.Successful, info.NodeID),
+			)
+			if err != nil {
+				m.logger.WithError(err).Errorf("failed to restore recovery info of producer %d", producerID)
+			}
+		}
+
+		if !producer.TimestampForRecovery().IsZero() || state.RecoveryFromTimestamp.IsZero() {
+			continue
+		}
+
+		// Timestamp out of the recovery window is refused and full recovery is requested
+		err = m.producerManager.SetProducerRecoveryFromTimestamp(producerID, state.RecoveryFromTimestamp)
+		if err != nil {
+			m.logger.WithError(err).Warnf("cannot recover producer %d from stored timestamp", producerID)
+		}
+	}
+}
+
+// persistState saves recovery state of all known producers
+func (m *Manager) persistState() {
+	store := m.cfg.RecoveryStateStore()
+	if store == nil {
+		return
+	}
+
+	m.lock.RLock()
+	producerIDs := make([]uint, 0, len(m.producerRecoveryData))
+	for producerID := range m.producerRecoveryData {
+		producerIDs = append(producerIDs, producerID)
+	}
+	m.lock.RUnlock()
+
+	states := make(map[uint]protocols.ProducerRecoveryState, len(producerIDs))
+	for _, producerID := range producerIDs {
+		producer, err := m.producerManager.GetProducer(producerID)
+		if err != nil {
+			m.logger.WithError(err).Errorf("failed to get recovery state of producer %d", producerID)
+			continue
+		}
+
+		state := protocols.ProducerRecoveryState{
+			ProducerID:                       producerID,
+			LastProcessedMessageGenTimestamp: producer.LastProcessedMessageGenTimestamp(),
+			RecoveryFromTimestamp:            producer.TimestampForRecovery(),
+		}
+
+		if info := producer.RecoveryInfo(); info != nil {
+			state.LastRecoveryInfo = &protocols.RecoveryInfoState{
+				After:      (*info).After(),
+				Timestamp:  (*info).Timestamp(),
+				RequestID:  (*info).RequestID(),
+				Successful: (*info).Successful(),
+				NodeID:     (*info).NodeID(),
+			}
+		}
+
+		states[producerID] = state
+	}
+
+	err := store.Save(states)
+	if err != nil {
+		m.logger.WithError(err).Error("failed to save recovery state")
+	}
+}
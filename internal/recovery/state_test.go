@@ -0,0 +1,58 @@
+package recovery
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestStateRestore(t *testing.T) {
+	api := newTestAPI(t)
+	cfg := newTestConfiguration(api)
+	cfg.stateStore = NewFileStateStore(filepath.Join(t.TempDir(), "recovery.json"))
+
+	now := cfg.clock.Now()
+	processed := now.Add(-10 * time.Minute)
+	alive := now.Add(-5 * time.Minute)
+	// Out of the recovery window of the producer
+	stale := now.Add(-2 * time.Hour)
+
+	m, producerManager, _ := openTestManager(t, cfg)
+	for producerID, timestamp := range map[uint]time.Time{1: alive, 2: stale} {
+		if err := producerManager.SetLastAliveReceivedGenTimestamp(producerID, timestamp); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := producerManager.SetLastProcessedMessageGenTimestamp(1, processed); err != nil {
+		t.Fatal(err)
+	}
+	if err := producerManager.SetProducerRecoveryInfo(1, newRecoveryInfoImpl(alive, now, 7, true, cfg.nodeID)); err != nil {
+		t.Fatal(err)
+	}
+	m.Close()
+
+	_, producerManager, _ = openTestManager(t, cfg)
+
+	restored, err := producerManager.GetProducer(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	switch info := restored.RecoveryInfo(); {
+	case !restored.LastProcessedMessageGenTimestamp().Equal(processed):
+		t.Fatalf("last processed timestamp %s was not restored", restored.LastProcessedMessageGenTimestamp())
+	case !restored.TimestampForRecovery().Equal(alive):
+		t.Fatalf("recovery timestamp %s was not restored", restored.TimestampForRecovery())
+	case info == nil || (*info).RequestID() != 7 || !(*info).Successful():
+		t.Fatal("recovery info was not restored")
+	}
+
+	refused, err := producerManager.GetProducer(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !refused.TimestampForRecovery().IsZero() {
+		t.Fatalf("timestamp out of recovery window was restored - %s", refused.TimestampForRecovery())
+	}
+}
@@ -0,0 +1,198 @@
+package recovery
+
+import (
+	"sync"
+	"time"
+
+	"github.com/oddin-gg/gosdk/protocols"
+)
+
+// Events without odds change or bet stop for this long are not suspended anymore
+const activeEventTimeout = 24 * time.Hour
+
+type activeEvent struct {
+	eventID  protocols.URN
+	sportID  *protocols.URN
+	lastSeen time.Time
+}
+
+// activeEvents tracks events of every producer which received odds
+type activeEvents struct {
+	lock   sync.Mutex
+	events map[uint]map[protocols.URN]activeEvent
+}
+
+func (a *activeEvents) seen(producerID uint, eventID protocols.URN, sportID *protocols.URN, timestamp time.Time) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	events, ok := a.events[producerID]
+	if !ok {
+		events = make(map[protocols.URN]activeEvent)
+		a.events[producerID] = events
+	}
+
+	events[eventID] = activeEvent{
+		eventID:  eventID,
+		sportID:  sportID,
+		lastSeen: timestamp,
+	}
+}
+
+func (a *activeEvents) list(producerID uint) []activeEvent {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	events := a.events[producerID]
+	result := make([]activeEvent, 0, len(events))
+	for _, event := range events {
+		result = append(result, event)
+	}
+
+	return result
+}
+
+func (a *activeEvents) prune(before time.Time) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	for _, events := range a.events {
+		for eventID, event := range events {
+			if event.lastSeen.Before(before) {
+				delete(events, eventID)
+			}
+		}
+	}
+}
+
+func newActiveEvents() *activeEvents {
+	return &activeEvents{
+		events: make(map[uint]map[protocols.URN]activeEvent),
+	}
+}
+
+// syntheticSender delivers synthetic messages in order on its own goroutine, so suspension of many events does not
+// block state changes of the producer until the application reads them
+type syntheticSender struct {
+	lock    sync.Mutex
+	pending []protocols.RecoveryMessage
+	readyCh chan struct{}
+	closeCh chan struct{}
+	wg      sync.WaitGroup
+}
+
+func (s *syntheticSender) start(msgCh chan<- protocols.RecoveryMessage) {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.run(msgCh)
+	}()
+}
+
+func (s *syntheticSender) send(messages []protocols.RecoveryMessage) {
+	if len(messages) == 0 {
+		return
+	}
+
+	s.lock.Lock()
+	s.pending = append(s.pending, messages...)
+	s.lock.Unlock()
+
+	select {
+	case s.readyCh <- struct{}{}:
+	default:
+	}
+}
+
+func (s *syntheticSender) run(msgCh chan<- protocols.RecoveryMessage) {
+	for {
+		select {
+		case <-s.readyCh:
+		case <-s.closeCh:
+			return
+		}
+
+		s.lock.Lock()
+		messages := s.pending
+		s.pending = nil
+		s.lock.Unlock()
+
+		for _, msg := range messages {
+			select {
+			case msgCh <- msg:
+			case <-s.closeCh:
+				return
+			}
+		}
+	}
+}
+
+// close stops the sender, messages which were not delivered yet are dropped
+func (s *syntheticSender) close() {
+	close(s.closeCh)
+	s.wg.Wait()
+}
+
+func newSyntheticSender() *syntheticSender {
+	return &syntheticSender{
+		readyCh: make(chan struct{}, 1),
+		closeCh: make(chan struct{}),
+	}
+}
+
+// OnEventMessageReceived ...
+func (m *Manager) OnEventMessageReceived(producerID uint, eventID protocols.URN, sportID *protocols.URN) {
+	if !m.cfg.SyntheticSuspension() {
+		return
+	}
+
+	m.activeEvents.seen(producerID, eventID, sportID, m.cfg.Clock().Now())
+}
+
+// suspendEvents sends synthetic bet stop for every active event of the producer which went down
+func (m *Manager) suspendEvents(data *producerRecoveryData, producer protocols.Producer, reason protocols.ProducerStatusReason, now time.Time) {
+	if m.synthetic == nil || data.suspendedEvents != nil {
+		return
+	}
+
+	timestamp := protocols.MessageTimestamp{
+		Created:   now,
+		Sent:      now,
+		Received:  now,
+		Published: now,
+	}
+
+	data.suspendedEvents = m.activeEvents.list(data.producerID)
+	messages := make([]protocols.RecoveryMessage, 0, len(data.suspendedEvents))
+	for _, event := range data.suspendedEvents {
+		messages = append(messages, protocols.RecoveryMessage{
+			SyntheticBetStop: m.feedMessageFactory.BuildSyntheticBetStop(producer, event.eventID, event.sportID, reason, timestamp),
+		})
+	}
+
+	m.synthetic.send(messages)
+}
+
+// resumeEvents sends synthetic bet start for every event suspended by suspendEvents
+func (m *Manager) resumeEvents(data *producerRecoveryData, producer protocols.Producer, reason protocols.ProducerStatusReason, now time.Time) {
+	if data.suspendedEvents == nil {
+		return
+	}
+
+	timestamp := protocols.MessageTimestamp{
+		Created:   now,
+		Sent:      now,
+		Received:  now,
+		Published: now,
+	}
+
+	messages := make([]protocols.RecoveryMessage, 0, len(data.suspendedEvents))
+	for _, event := range data.suspendedEvents {
+		messages = append(messages, protocols.RecoveryMessage{
+			SyntheticBetStart: m.feedMessageFactory.BuildSyntheticBetStart(producer, event.eventID, event.sportID, reason, timestamp),
+		})
+	}
+
+	m.synthetic.send(messages)
+	data.suspendedEvents = nil
+}
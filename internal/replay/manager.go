@@ -1,12 +1,15 @@
 package replay
 
 import (
+	"context"
+
 	"github.com/oddin-gg/gosdk/internal/api"
 	"github.com/oddin-gg/gosdk/protocols"
 )
 
 // Manager ...
 type Manager struct {
+	ctx                   context.Context
 	apiClient             *api.Client
 	oddsFeedConfiguration protocols.OddsFeedConfiguration
 	sportsInfoManager     protocols.SportsInfoManager
@@ -14,7 +17,7 @@ type Manager struct {
 
 // ReplayList ...
 func (m *Manager) ReplayList() ([]protocols.SportEvent, error) {
-	events, err := m.apiClient.FetchReplaySetContent(m.oddsFeedConfiguration.SdkNodeID())
+	events, err := m.apiClient.FetchReplaySetContent(m.ctx, m.oddsFeedConfiguration.SdkNodeID())
 	if err != nil {
 		return nil, err
 	}
@@ -25,7 +28,7 @@ func (m *Manager) ReplayList() ([]protocols.SportEvent, error) {
 		if err != nil {
 			return nil, err
 		}
-		match, err := m.sportsInfoManager.Match(*id)
+		match, err := m.sportsInfoManager.WithContext(m.ctx).Match(*id)
 		if err != nil {
 			return nil, err
 		}
@@ -42,7 +45,7 @@ func (m *Manager) AddSportEvent(event protocols.SportEvent) (bool, error) {
 
 // AddSportEventID ...
 func (m *Manager) AddSportEventID(id protocols.URN) (bool, error) {
-	return m.apiClient.PutReplayEvent(id, m.oddsFeedConfiguration.SdkNodeID())
+	return m.apiClient.PutReplayEvent(m.ctx, id, m.oddsFeedConfiguration.SdkNodeID())
 }
 
 // RemoveSportEvent ...
@@ -52,12 +55,13 @@ func (m *Manager) RemoveSportEvent(event protocols.SportEvent) (bool, error) {
 
 // RemoveSportEventID ...
 func (m *Manager) RemoveSportEventID(id protocols.URN) (bool, error) {
-	return m.apiClient.DeleteReplayEvent(id, m.oddsFeedConfiguration.SdkNodeID())
+	return m.apiClient.DeleteReplayEvent(m.ctx, id, m.oddsFeedConfiguration.SdkNodeID())
 }
 
 // Play ...
 func (m *Manager) Play(params protocols.ReplayPlayParams) (bool, error) {
 	return m.apiClient.PostReplayStart(
+		m.ctx,
 		m.oddsFeedConfiguration.SdkNodeID(),
 		params.Speed,
 		params.MaxDelayInMs,
@@ -69,17 +73,28 @@ func (m *Manager) Play(params protocols.ReplayPlayParams) (bool, error) {
 
 // Stop ...
 func (m *Manager) Stop() (bool, error) {
-	return m.apiClient.PostReplayStop(m.oddsFeedConfiguration.SdkNodeID())
+	return m.apiClient.PostReplayStop(m.ctx, m.oddsFeedConfiguration.SdkNodeID())
 }
 
 // Clear ...
 func (m *Manager) Clear() (bool, error) {
-	return m.apiClient.PostReplayClear(m.oddsFeedConfiguration.SdkNodeID())
+	return m.apiClient.PostReplayClear(m.ctx, m.oddsFeedConfiguration.SdkNodeID())
+}
+
+// WithContext returns manager which uses ctx for API calls
+func (m *Manager) WithContext(ctx context.Context) protocols.ReplayManager {
+	return &Manager{
+		ctx:                   ctx,
+		apiClient:             m.apiClient,
+		oddsFeedConfiguration: m.oddsFeedConfiguration,
+		sportsInfoManager:     m.sportsInfoManager,
+	}
 }
 
 // NewManager ...
 func NewManager(apiClient *api.Client, oddsFeedConfiguration protocols.OddsFeedConfiguration, sportsInfoManager protocols.SportsInfoManager) protocols.ReplayManager {
 	return &Manager{
+		ctx:                   context.Background(),
 		apiClient:             apiClient,
 		oddsFeedConfiguration: oddsFeedConfiguration,
 		sportsInfoManager:     sportsInfoManager,
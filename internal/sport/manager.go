@@ -1,6 +1,7 @@
 package sport
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -32,7 +33,8 @@ func (f fixtureChangeImpl) UpdateTime() time.Time {
 
 // Manager ...
 type Manager struct {
-	entityFactory         *factory.EntityFactory
+	ctx                   context.Context
+	entityFactory         protocols.EntityFactory
 	apiClient             *api.Client
 	oddsFeedConfiguration protocols.OddsFeedConfiguration
 	cacheManager          *cache.Manager
@@ -105,7 +107,7 @@ func (m *Manager) MatchesFor(date time.Time) ([]protocols.Match, error) {
 
 // LocalizedMatchesFor ...
 func (m *Manager) LocalizedMatchesFor(date time.Time, locale protocols.Locale) ([]protocols.Match, error) {
-	data, err := m.apiClient.FetchMatches(date, locale)
+	data, err := m.apiClient.FetchMatches(m.ctx, date, locale)
 	if err != nil {
 		return nil, err
 	}
@@ -129,7 +131,7 @@ func (m *Manager) LiveMatches() ([]protocols.Match, error) {
 
 // LocalizedLiveMatches ...
 func (m *Manager) LocalizedLiveMatches(locale protocols.Locale) ([]protocols.Match, error) {
-	data, err := m.apiClient.FetchLiveMatches(locale)
+	data, err := m.apiClient.FetchLiveMatches(m.ctx, locale)
 	if err != nil {
 		return nil, err
 	}
@@ -173,7 +175,7 @@ func (m *Manager) FixtureChanges(after time.Time) ([]protocols.FixtureChange, er
 
 // LocalizedFixtureChanges ...
 func (m *Manager) LocalizedFixtureChanges(locale protocols.Locale, after time.Time) ([]protocols.FixtureChange, error) {
-	data, err := m.apiClient.FetchFixtureChanges(locale, after)
+	data, err := m.apiClient.FetchFixtureChanges(m.ctx, locale, after)
 	if err != nil {
 		return nil, err
 	}
@@ -218,7 +220,7 @@ func (m *Manager) LocalizedListOfMatches(startIndex uint, limit uint, locale pro
 		return nil, fmt.Errorf("min limit is 1")
 	}
 
-	data, err := m.apiClient.FetchSchedule(startIndex, limit, locale)
+	data, err := m.apiClient.FetchSchedule(m.ctx, startIndex, limit, locale)
 	if err != nil {
 		return nil, err
 	}
@@ -242,7 +244,7 @@ func (m *Manager) AvailableTournaments(sportID protocols.URN) ([]protocols.Tourn
 
 // LocalizedAvailableTournaments ...
 func (m *Manager) LocalizedAvailableTournaments(sportID protocols.URN, locale protocols.Locale) ([]protocols.Tournament, error) {
-	data, err := m.apiClient.FetchTournaments(sportID, locale)
+	data, err := m.apiClient.FetchTournaments(m.ctx, sportID, locale)
 	if err != nil {
 		return nil, err
 	}
@@ -274,9 +276,21 @@ func (m *Manager) ClearCompetitor(id protocols.URN) {
 	m.cacheManager.CompetitorCache.ClearCacheItem(id)
 }
 
+// WithContext returns manager which uses ctx for API calls, including lazy loading of returned entities
+func (m *Manager) WithContext(ctx context.Context) protocols.SportsInfoManager {
+	return &Manager{
+		ctx:                   ctx,
+		entityFactory:         m.entityFactory.WithContext(ctx),
+		apiClient:             m.apiClient,
+		oddsFeedConfiguration: m.oddsFeedConfiguration,
+		cacheManager:          m.cacheManager,
+	}
+}
+
 // NewManager ...
 func NewManager(entityFactory *factory.EntityFactory, apiClient *api.Client, cacheManager *cache.Manager, oddsFeedConfiguration protocols.OddsFeedConfiguration) *Manager {
 	return &Manager{
+		ctx:                   context.Background(),
 		entityFactory:         entityFactory,
 		apiClient:             apiClient,
 		cacheManager:          cacheManager,
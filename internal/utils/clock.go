@@ -0,0 +1,39 @@
+package utils
+
+import (
+	"time"
+
+	"github.com/oddin-gg/gosdk/protocols"
+)
+
+// SystemClock ...
+type SystemClock struct{}
+
+// Now ...
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+// After ...
+func (SystemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// NewTicker ...
+func (SystemClock) NewTicker(d time.Duration) protocols.Ticker {
+	return systemTicker{
+		ticker: time.NewTicker(d),
+	}
+}
+
+type systemTicker struct {
+	ticker *time.Ticker
+}
+
+func (s systemTicker) C() <-chan time.Time {
+	return s.ticker.C
+}
+
+func (s systemTicker) Stop() {
+	s.ticker.Stop()
+}
@@ -1,6 +1,7 @@
 package whoami
 
 import (
+	"context"
 	"github.com/oddin-gg/gosdk/internal/api"
 	"github.com/oddin-gg/gosdk/protocols"
 	log "github.com/sirupsen/logrus"
@@ -54,7 +55,7 @@ func (m *Manager) BookmakerDetails() (protocols.BookmakerDetail, error) {
 }
 
 func (m *Manager) fetchBookmakerDetails() (protocols.BookmakerDetail, error) {
-	details, err := m.apiClient.FetchWhoAmI()
+	details, err := m.apiClient.FetchWhoAmI(context.Background())
 	if err != nil {
 		return nil, err
 	}
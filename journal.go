@@ -0,0 +1,18 @@
+package gosdk
+
+import (
+	"errors"
+
+	"github.com/oddin-gg/gosdk/internal/journal"
+	"github.com/oddin-gg/gosdk/protocols"
+)
+
+// NewJournalPlayer creates player which publishes recorded journal into in-memory transport set in configuration
+func NewJournalPlayer(cfg protocols.OddsFeedConfiguration, params protocols.JournalPlayParams) (protocols.JournalPlayer, error) {
+	transport, ok := cfg.Transport().(protocols.InMemoryTransport)
+	if !ok {
+		return nil, errors.New("journal can be played only with in-memory transport")
+	}
+
+	return journal.NewPlayer(transport, cfg.ExchangeName(), params), nil
+}
@@ -0,0 +1,74 @@
+package gosdk
+
+import (
+	"fmt"
+
+	"github.com/oddin-gg/gosdk/protocols"
+)
+
+// dispatchSessionMessage calls listener method for the message type, panics are returned as errors
+func dispatchSessionMessage(listener protocols.OddsFeedListener, msg protocols.SessionMessage) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("listener panicked: %v", r)
+		}
+	}()
+
+	switch {
+	case msg.RawFeedMessage != nil:
+		return listener.OnRawFeedMessage(msg.RawFeedMessage)
+	case msg.UnparsableMessage != nil:
+		return listener.OnUnparsableMessage(msg.UnparsableMessage)
+	}
+
+	switch message := msg.Message.(type) {
+	case protocols.OddsChange:
+		return listener.OnOddsChange(message)
+	case protocols.BetStop:
+		return listener.OnBetStop(message)
+	case protocols.BetSettlement:
+		return listener.OnBetSettlement(message)
+	case protocols.BetCancel:
+		return listener.OnBetCancel(message)
+	case protocols.FixtureChangeMessage:
+		return listener.OnFixtureChange(message)
+	case protocols.RollbackBetSettlement:
+		return listener.OnRollbackBetSettlement(message)
+	case protocols.RollbackBetCancel:
+		return listener.OnRollbackBetCancel(message)
+	default:
+		return fmt.Errorf("unknown session message %T", message)
+	}
+}
+
+// dispatchGlobalMessage calls listener method for the message type, panics are returned as errors
+func dispatchGlobalMessage(listener protocols.GlobalListener, msg protocols.GlobalMessage) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("listener panicked: %v", r)
+		}
+	}()
+
+	switch {
+	case msg.Recovery != nil && msg.Recovery.ProducerStatus != nil:
+		return listener.OnProducerStatus(msg.Recovery.ProducerStatus)
+	case msg.Recovery != nil && msg.Recovery.EventRecoveryMessage != nil:
+		return listener.OnEventRecovery(msg.Recovery.EventRecoveryMessage)
+	case msg.Recovery != nil && msg.Recovery.SyntheticBetStop != nil:
+		if suspensionListener, ok := listener.(protocols.SyntheticSuspensionListener); ok {
+			return suspensionListener.OnSyntheticBetStop(msg.Recovery.SyntheticBetStop)
+		}
+		return nil
+	case msg.Recovery != nil && msg.Recovery.SyntheticBetStart != nil:
+		if suspensionListener, ok := listener.(protocols.SyntheticSuspensionListener); ok {
+			return suspensionListener.OnSyntheticBetStart(msg.Recovery.SyntheticBetStart)
+		}
+		return nil
+	case msg.ConnectionStatus != nil:
+		return listener.OnConnectionStatus(msg.ConnectionStatus)
+	case msg.APIMessage != nil:
+		return listener.OnAPIResponse(*msg.APIMessage)
+	default:
+		return nil
+	}
+}
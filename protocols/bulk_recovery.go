@@ -0,0 +1,60 @@
+package protocols
+
+import "time"
+
+// EventRecoveryKind ...
+type EventRecoveryKind int
+
+// EventRecoveryKinds
+const (
+	OddsEventRecoveryKind     EventRecoveryKind = 0
+	StatefulEventRecoveryKind EventRecoveryKind = 1
+)
+
+// BulkEventRecoveryRequest ...
+type BulkEventRecoveryRequest struct {
+	ProducerID uint
+	EventIDs   []URN
+	Kind       EventRecoveryKind
+	// Concurrency limits number of recovery requests in flight, one request at a time by default
+	Concurrency int
+	// RequestsPerSecond limits rate of recovery requests, rate is not limited when zero
+	RequestsPerSecond float64
+	// Timeout of the event recovery measured from its request, max recovery execution time by default.
+	// Timeouts are checked every second.
+	Timeout time.Duration
+}
+
+// EventRecoveryStatus ...
+type EventRecoveryStatus int
+
+// EventRecoveryStatuses
+const (
+	PendingEventRecoveryStatus   EventRecoveryStatus = 0
+	RequestedEventRecoveryStatus EventRecoveryStatus = 1
+	CompletedEventRecoveryStatus EventRecoveryStatus = 2
+	FailedEventRecoveryStatus    EventRecoveryStatus = 3
+	TimedOutEventRecoveryStatus  EventRecoveryStatus = 4
+)
+
+// IsFinal ...
+func (e EventRecoveryStatus) IsFinal() bool {
+	return e == CompletedEventRecoveryStatus || e == FailedEventRecoveryStatus || e == TimedOutEventRecoveryStatus
+}
+
+// EventRecoveryProgress ...
+type EventRecoveryProgress struct {
+	EventID   URN
+	RequestID uint
+	Status    EventRecoveryStatus
+	// Err is set when the recovery request failed
+	Err error
+}
+
+// BulkEventRecovery ...
+type BulkEventRecovery interface {
+	// Progress returns state of every event in order of the request
+	Progress() []EventRecoveryProgress
+	// Done is closed once all events completed, failed or timed out
+	Done() <-chan struct{}
+}
@@ -0,0 +1,16 @@
+package protocols
+
+import "time"
+
+// Ticker ...
+type Ticker interface {
+	C() <-chan time.Time
+	Stop()
+}
+
+// Clock is the source of time of the feed, it can be replaced to control time in tests
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+	NewTicker(d time.Duration) Ticker
+}
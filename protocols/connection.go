@@ -0,0 +1,25 @@
+package protocols
+
+import "time"
+
+// ConnectionState ...
+type ConnectionState int
+
+// ConnectionStates
+const (
+	ConnectingConnectionState   ConnectionState = 1
+	ConnectedConnectionState    ConnectionState = 2
+	ReconnectingConnectionState ConnectionState = 3
+	ClosedConnectionState       ConnectionState = 4
+)
+
+// ConnectionStatus ...
+type ConnectionStatus interface {
+	ConnectionDownMessage
+	State() ConnectionState
+	Timestamp() time.Time
+	// Attempt is number of the reconnect attempt, zero when not reconnecting
+	Attempt() uint
+	// Err is the reason of connection loss if known
+	Err() error
+}
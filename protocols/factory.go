@@ -1,5 +1,7 @@
 package protocols
 
+import "context"
+
 // EntityFactory ...
 type EntityFactory interface {
 	BuildTournaments(tournamentIDs []URN, sportID URN, locales []Locale) []Tournament
@@ -13,4 +15,5 @@ type EntityFactory interface {
 	BuildMatchStatus(id URN, locales []Locale) MatchStatus
 	BuildMatches(ids []URN, locales []Locale) []Match
 	BuildMatch(id URN, locales []Locale, sportID *URN) Match
+	WithContext(ctx context.Context) EntityFactory
 }
@@ -25,6 +25,7 @@ type QueueMessage struct {
 	RawFeedMessage    *RawFeedMessage
 	FeedMessage       *FeedMessage
 	UnparsableMessage UnparsableMessage
+	Acknowledger      Acknowledger
 }
 
 // SessionMessage ...
@@ -32,4 +33,33 @@ type SessionMessage struct {
 	RawFeedMessage    *RawFeedMessage
 	Message           interface{}
 	UnparsableMessage UnparsableMessage
+	Acknowledger      Acknowledger
+	// FromRecovery is set when message was sent as a response to recovery request
+	FromRecovery bool
+}
+
+// Ack confirms the message was processed, it has effect only when manual acknowledgement is enabled
+func (s SessionMessage) Ack() error {
+	if s.Acknowledger == nil {
+		return nil
+	}
+
+	return s.Acknowledger.Ack()
+}
+
+// TypedMessage is session message of single kind, see gosdk.Subscribe
+type TypedMessage[T any] struct {
+	Message      T
+	Acknowledger Acknowledger
+	// FromRecovery is set when message was sent as a response to recovery request
+	FromRecovery bool
+}
+
+// Ack confirms the message was processed, it has effect only when manual acknowledgement is enabled
+func (t TypedMessage[T]) Ack() error {
+	if t.Acknowledger == nil {
+		return nil
+	}
+
+	return t.Acknowledger.Ack()
 }
@@ -0,0 +1,18 @@
+package protocols
+
+// SessionFilter drops or trims session messages before they are built, empty criteria are not applied.
+// Markets are removed from odds changes, settlements, cancels and their rollbacks. Raw data is kept intact,
+// RawMessage bytes, RawFeedMessage delivered with extended data and journal records contain all markets.
+type SessionFilter struct {
+	// MarketIDs keeps only markets with listed ids
+	MarketIDs []uint
+	// MarketVariants keeps only markets with listed variant, markets without variant specifier always pass it
+	MarketVariants []string
+	// MarketGroups keeps only markets which description groups intersect the list
+	MarketGroups []string
+	// MaxRiskTier drops messages of tournaments, and their matches, with risk tier above the value,
+	// the bound is inclusive so tournaments with risk tier equal to the value are kept
+	MaxRiskTier *int
+	// SportFormats keeps only messages of matches with listed sport format
+	SportFormats []SportFormat
+}
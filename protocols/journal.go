@@ -0,0 +1,34 @@
+package protocols
+
+// JournalParams ...
+type JournalParams struct {
+	// Directory where journal files are created, it has to exist
+	Directory string
+	// MaxFileSize in bytes of uncompressed data after which a new journal file is started, 100 MB is used when zero
+	MaxFileSize int64
+	// Gzip compresses journal files
+	Gzip bool
+}
+
+// JournalPlayParams ...
+type JournalPlayParams struct {
+	// Directory with journal files, files are played in order of their names
+	Directory string
+	// Speed multiplies original pace of the journal, 1 plays at original pace, zero plays as fast as possible
+	Speed float64
+	// MessageInterest selects records consumed by sessions with this interest, it is ignored when All or Session
+	// is set. Records of the system alive session are played only when SystemAliveOnly is selected.
+	MessageInterest MessageInterest
+	// Session selects records consumed by the n-th built session, counted from 1
+	Session int
+	// All plays records of all sessions but the system alive session. Messages consumed by several sessions,
+	// e.g. alive messages, are recorded once per session, so they are played multiple times.
+	All bool
+}
+
+// JournalPlayer publishes recorded deliveries into in-memory transport
+type JournalPlayer interface {
+	// Play blocks until the whole journal is played or player is stopped
+	Play() error
+	Stop()
+}
@@ -0,0 +1,33 @@
+package protocols
+
+// OddsFeedListener receives session messages instead of session channel,
+// errors returned by listener are logged and counted by the session. With manual acknowledgement
+// failed messages are rejected, so the broker dead-letters or drops them, unless requeue is enabled
+// by OddsFeedSessionBuilder.SetRequeueOnListenerError.
+type OddsFeedListener interface {
+	OnOddsChange(message OddsChange) error
+	OnBetStop(message BetStop) error
+	OnBetSettlement(message BetSettlement) error
+	OnBetCancel(message BetCancel) error
+	OnFixtureChange(message FixtureChangeMessage) error
+	OnRollbackBetSettlement(message RollbackBetSettlement) error
+	OnRollbackBetCancel(message RollbackBetCancel) error
+	OnUnparsableMessage(message UnparsableMessage) error
+	// OnRawFeedMessage is called only when extended data reporting is enabled
+	OnRawFeedMessage(message *RawFeedMessage) error
+}
+
+// GlobalListener receives global messages instead of the channel returned from OddsFeed.Open
+type GlobalListener interface {
+	OnProducerStatus(message ProducerStatus) error
+	OnEventRecovery(message EventRecoveryMessage) error
+	OnConnectionStatus(status ConnectionStatus) error
+	// OnAPIResponse is called only when extended data reporting is enabled
+	OnAPIResponse(response Response) error
+}
+
+// SyntheticSuspensionListener can be implemented by GlobalListener to receive synthetic suspensions of events
+type SyntheticSuspensionListener interface {
+	OnSyntheticBetStop(message SyntheticBetStop) error
+	OnSyntheticBetStart(message SyntheticBetStart) error
+}
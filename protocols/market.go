@@ -1,5 +1,7 @@
 package protocols
 
+import "context"
+
 // MarketData ...
 type MarketData interface {
 	MarketName(locale Locale) (*string, error)
@@ -97,8 +99,11 @@ type MarketDescriptionManager interface {
 	ClearMarketDescription(marketID uint, variant *string)
 	MarketVoidReasons() ([]MarketVoidReason, error)
 	ReloadMarketVoidReasons() ([]MarketVoidReason, error)
+	WithContext(ctx context.Context) MarketDescriptionManager
 }
 
 const (
 	MarketGroupPlayerProps = "player_props"
+	// MarketGroupAll matches all markets
+	MarketGroupAll = "all"
 )
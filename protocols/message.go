@@ -65,7 +65,12 @@ type OddsChange interface {
 type BetStop interface {
 	RequestMessage
 	EventMessage
-	isBetStop()
+	// Groups of markets which are stopped
+	Groups() []string
+	// MarketStatus is the status stopped markets should be set to, markets are suspended by default
+	MarketStatus() MarketStatus
+	// AffectedMarkets resolves descriptions of markets which groups match the bet stop groups
+	AffectedMarkets() ([]MarketDescription, error)
 }
 
 // BetSettlement ...
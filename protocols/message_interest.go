@@ -14,8 +14,39 @@ const (
 	SpecifiedMatchesOnlyMessageInterest MessageInterest = ""
 	AllMessageInterest                  MessageInterest = "*.*.*.*.*.*.*"
 	SystemAliveOnly                     MessageInterest = "-.-.-.alive.#"
+	// SpecifiedSportsOnlyMessageInterest subscribes only messages of selected sports
+	SpecifiedSportsOnlyMessageInterest MessageInterest = "sports"
+	// SpecifiedTournamentsOnlyMessageInterest receives all messages and filters those which do not belong to
+	// selected tournaments, tournament of every match is resolved via API once
+	SpecifiedTournamentsOnlyMessageInterest MessageInterest = "tournaments"
 )
 
+// Name ...
+func (m MessageInterest) Name() string {
+	switch m {
+	case LiveOnlyMessageInterest:
+		return "live"
+	case PrematchOnlyMessageInterest:
+		return "prematch"
+	case HiPriorityOnlyMessageInterest:
+		return "hi"
+	case LowPriorityOnlyMessageInterest:
+		return "lo"
+	case SpecifiedMatchesOnlyMessageInterest:
+		return "specified"
+	case AllMessageInterest:
+		return "all"
+	case SystemAliveOnly:
+		return "alive"
+	case SpecifiedSportsOnlyMessageInterest:
+		return "sports"
+	case SpecifiedTournamentsOnlyMessageInterest:
+		return "tournaments"
+	default:
+		return "unknown"
+	}
+}
+
 // PossibleSourceProducers ...
 func (m MessageInterest) PossibleSourceProducers(availableProducers map[uint]Producer) []uint {
 	var possibleProducers []uint
@@ -0,0 +1,9 @@
+package protocols
+
+// MessageHandler builds session message from the feed message, nil message is not delivered
+type MessageHandler func(feedMessage *FeedMessage) (interface{}, error)
+
+// MessageMiddleware wraps the message handler. It can change the feed message before calling next handler,
+// change or wrap the built message (e.g. by embedding OddsChange into own type), measure the processing time
+// or drop the message by returning nil message without calling next handler.
+type MessageMiddleware func(next MessageHandler) MessageHandler
@@ -1,9 +1,12 @@
 package protocols
 
+import "context"
+
 // GlobalMessage ...
 type GlobalMessage struct {
-	APIMessage *Response
-	Recovery   *RecoveryMessage
+	APIMessage       *Response
+	Recovery         *RecoveryMessage
+	ConnectionStatus ConnectionStatus
 }
 
 // GlobalMessageDelivery ...
@@ -20,4 +23,10 @@ type OddsFeed interface {
 	ReplayManager() (ReplayManager, error)
 	Close() error
 	Open() (GlobalMessageDelivery, error)
+	// OpenWithContext opens the feed which is closed once ctx is done, ctx is used for API calls made by the feed
+	OpenWithContext(ctx context.Context) (GlobalMessageDelivery, error)
+	// OpenWithListener opens the feed and delivers global messages to the listener
+	OpenWithListener(listener GlobalListener) error
+	// ListenerErrors returns number of errors returned by global listener
+	ListenerErrors() uint64
 }
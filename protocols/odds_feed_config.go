@@ -2,6 +2,7 @@ package protocols
 
 import (
 	"fmt"
+	"time"
 )
 
 // Environment ...
@@ -63,6 +64,15 @@ const (
 	ZhLocale Locale = "zh"
 )
 
+// QueueOptions ...
+type QueueOptions struct {
+	// NamePrefix enables durable named queues, every session consumes from its own queue with this prefix
+	NamePrefix string
+	// ManualAck requires SessionMessage.Ack to be called once the message is processed
+	ManualAck     bool
+	PrefetchCount int
+}
+
 // OddsFeedConfiguration ...
 type OddsFeedConfiguration interface {
 	AccessToken() *string
@@ -85,4 +95,53 @@ type OddsFeedConfiguration interface {
 	MQURL() (string, error)
 	SportIDPrefix() string
 	SetSportIDPrefix(prefix string) OddsFeedConfiguration
+	Transport() Transport
+	SetTransport(transport Transport) OddsFeedConfiguration
+	QueueOptions() QueueOptions
+	SetQueueOptions(options QueueOptions) OddsFeedConfiguration
+	ChannelBufferSize() int
+	// SetChannelBufferSize sets buffer size of session and global message channels, channels are unbuffered by default
+	SetChannelBufferSize(size int) OddsFeedConfiguration
+	Journal() *JournalParams
+	// SetJournal enables recording of every consumed delivery into rotating journal files
+	SetJournal(params JournalParams) OddsFeedConfiguration
+	RecoveryStateStore() RecoveryStateStore
+	// SetRecoveryStateStore persists recovery state, so recovery after restart starts from the last processed messages
+	SetRecoveryStateStore(store RecoveryStateStore) OddsFeedConfiguration
+	RecoveryCoordinator() RecoveryCoordinator
+	// SetRecoveryCoordinator serializes snapshot recovery of feeds using the same access token, only one of them requests
+	// it at a time. Only feeds with the same node id reuse the result, the others request their own recovery afterwards.
+	SetRecoveryCoordinator(coordinator RecoveryCoordinator) OddsFeedConfiguration
+	Clock() Clock
+	// SetClock replaces the system clock used by recovery, sessions and caches
+	SetClock(clock Clock) OddsFeedConfiguration
+	RecoveryInitialDelay() time.Duration
+	// SetRecoveryInitialDelay sets delay before producers are checked for the first time, 60 seconds by default.
+	// Non-positive delay is ignored.
+	SetRecoveryInitialDelay(delay time.Duration) OddsFeedConfiguration
+	RecoveryTickPeriod() time.Duration
+	// SetRecoveryTickPeriod sets period of producer checks, 10 seconds by default. Non-positive period is ignored.
+	SetRecoveryTickPeriod(period time.Duration) OddsFeedConfiguration
+	SlowProcessingThreshold() time.Duration
+	// SetSlowProcessingThreshold sets processing time of a message after which a warning is logged, 1 second by default.
+	// Non-positive threshold is ignored.
+	SetSlowProcessingThreshold(threshold time.Duration) OddsFeedConfiguration
+	RecoveryHistorySize() int
+	// SetRecoveryHistorySize sets number of recovery history entries kept in memory, 1000 by default
+	SetRecoveryHistorySize(size int) OddsFeedConfiguration
+	RecoveryHistorySink() RecoveryHistorySink
+	// SetRecoveryHistorySink streams every recovery history entry to the sink
+	SetRecoveryHistorySink(sink RecoveryHistorySink) OddsFeedConfiguration
+	SyntheticSuspension() bool
+	// SetSyntheticSuspension enables SyntheticBetStop of every active event when its producer goes down and
+	// SyntheticBetStart when the producer goes up
+	SetSyntheticSuspension(enabled bool) OddsFeedConfiguration
+	APITimeout() time.Duration
+	// SetAPITimeout sets timeout of a single API request attempt, 10 seconds by default. Use context of the call
+	// to limit the whole call including retries. Non-positive timeout is ignored.
+	SetAPITimeout(timeout time.Duration) OddsFeedConfiguration
+	StaticDataRefreshPeriod() time.Duration
+	// SetStaticDataRefreshPeriod sets period of refreshing cached static data, e.g. localized match statuses,
+	// 24 hours by default. Non-positive period is ignored.
+	SetStaticDataRefreshPeriod(period time.Duration) OddsFeedConfiguration
 }
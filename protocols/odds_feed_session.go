@@ -1,6 +1,8 @@
 package protocols
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 )
 
@@ -11,6 +13,13 @@ type SessionMessageDelivery <-chan SessionMessage
 type OddsFeedSession interface {
 	ID() uuid.UUID
 	RespCh() SessionMessageDelivery
+	// AddEvent starts receiving messages of the event, session has to be opened with SpecifiedMatchesOnlyMessageInterest
+	AddEvent(eventID URN) error
+	// AddEventWithRecovery adds event and requests recovery of its odds from the producer, request id is returned
+	AddEventWithRecovery(eventID URN, producerID uint) (uint, error)
+	RemoveEvent(eventID URN) error
+	// ListenerErrors returns number of errors returned by session listener
+	ListenerErrors() uint64
 }
 
 // OddsFeedSessionBuilder ...
@@ -18,6 +27,30 @@ type OddsFeedSessionBuilder interface {
 	SetMessageInterest(messageInterest MessageInterest) OddsFeedSessionBuilder
 	SetSpecificEventsOnly(specificEvents []URN) OddsFeedSessionBuilder
 	SetSpecificEventOnly(specificEventOnly URN) OddsFeedSessionBuilder
+	// SetSpecificSportsOnly is used with SpecifiedSportsOnlyMessageInterest
+	SetSpecificSportsOnly(sportIDs []URN) OddsFeedSessionBuilder
+	// SetSpecificTournamentsOnly is used with SpecifiedTournamentsOnlyMessageInterest
+	SetSpecificTournamentsOnly(tournamentIDs []URN) OddsFeedSessionBuilder
+	// SetProcessingWorkers processes messages of different events in parallel, messages of
+	// single event are always delivered in order. System messages are processed by the first worker,
+	// snapshot_complete only after all workers processed messages received before it.
+	SetProcessingWorkers(workers int) OddsFeedSessionBuilder
+	// SetOverflow enables bounded queue in front of session processing, settlements and cancels are never dropped
+	SetOverflow(params OverflowParams) OddsFeedSessionBuilder
+	// SetDeduplication drops messages already processed within the window, e.g. the ones received
+	// both from live stream and from recovery. Messages are compared without request id.
+	SetDeduplication(window time.Duration) OddsFeedSessionBuilder
+	// SetFilter drops messages of events and markets which do not match the filter
+	SetFilter(filter SessionFilter) OddsFeedSessionBuilder
+	// AddMiddleware adds middleware around building of session messages, the first added middleware is the outermost
+	AddMiddleware(middleware MessageMiddleware) OddsFeedSessionBuilder
 	Build() (SessionMessageDelivery, error)
+	// BuildSession returns the session itself, events of specified matches session can be changed once feed is opened
+	BuildSession() (OddsFeedSession, error)
+	// SetRequeueOnListenerError returns messages failed by the listener to the queue instead of rejecting them,
+	// message which was already redelivered is rejected to not retry it forever
+	SetRequeueOnListenerError(requeue bool) OddsFeedSessionBuilder
+	// BuildWithListener delivers session messages to the listener, session channel is not used
+	BuildWithListener(listener OddsFeedListener) (OddsFeedSession, error)
 	BuildReplay() (SessionMessageDelivery, error)
 }
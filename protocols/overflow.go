@@ -0,0 +1,66 @@
+package protocols
+
+import "sync/atomic"
+
+// OverflowPolicy ...
+type OverflowPolicy int
+
+// OverflowPolicies
+const (
+	// BlockOverflowPolicy blocks the message consumer until there is space in the queue
+	BlockOverflowPolicy OverflowPolicy = 0
+	// DropOldestOddsChangeOverflowPolicy replaces the oldest queued odds change of the same event,
+	// other message types are never dropped and block when the queue is full
+	DropOldestOddsChangeOverflowPolicy OverflowPolicy = 1
+	// SpillToDiskOverflowPolicy writes messages which do not fit into the queue to a temporary file
+	SpillToDiskOverflowPolicy OverflowPolicy = 2
+)
+
+// OverflowParams ...
+type OverflowParams struct {
+	Policy OverflowPolicy
+	// Capacity of the in-memory queue between message consumer and session processing, zero disables the queue
+	Capacity int
+	// SpillDirectory is used by SpillToDiskOverflowPolicy, default temporary directory is used when empty
+	SpillDirectory string
+	// Stats is optional, dropped and spilled messages are counted there
+	Stats *OverflowStats
+}
+
+// OverflowStats ...
+type OverflowStats struct {
+	dropped atomic.Uint64
+	spilled atomic.Uint64
+}
+
+// Dropped ...
+func (o *OverflowStats) Dropped() uint64 {
+	if o == nil {
+		return 0
+	}
+
+	return o.dropped.Load()
+}
+
+// Spilled ...
+func (o *OverflowStats) Spilled() uint64 {
+	if o == nil {
+		return 0
+	}
+
+	return o.spilled.Load()
+}
+
+// RecordDropped ...
+func (o *OverflowStats) RecordDropped() {
+	if o != nil {
+		o.dropped.Add(1)
+	}
+}
+
+// RecordSpilled ...
+func (o *OverflowStats) RecordSpilled() {
+	if o != nil {
+		o.spilled.Add(1)
+	}
+}
@@ -1,6 +1,9 @@
 package protocols
 
-import "time"
+import (
+	"context"
+	"time"
+)
 
 // ProducerScope ...
 type ProducerScope int
@@ -48,4 +51,9 @@ type ProducerManager interface {
 	SetProducerRecoveryFromTimestamp(producerID uint, timestamp time.Time) error
 	IsProducerEnabled(id uint) (bool, error)
 	IsProducerDown(id uint) (bool, error)
+	// Watch delivers changes of all producers until ctx is done or the feed is closed, changes are dropped when
+	// the buffer is full
+	Watch(ctx context.Context, bufferSize int) <-chan ProducerChange
+	// WithContext returns manager which uses ctx for API calls
+	WithContext(ctx context.Context) ProducerManager
 }
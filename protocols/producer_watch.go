@@ -0,0 +1,21 @@
+package protocols
+
+// ProducerChangeType ...
+type ProducerChangeType int
+
+// ProducerChangeTypes
+const (
+	EnabledProducerChangeType                          ProducerChangeType = 1
+	FlaggedDownProducerChangeType                      ProducerChangeType = 2
+	RecoveryInfoProducerChangeType                     ProducerChangeType = 3
+	RecoveryFromTimestampProducerChangeType            ProducerChangeType = 4
+	LastMessageTimestampProducerChangeType             ProducerChangeType = 5
+	LastProcessedMessageGenTimestampProducerChangeType ProducerChangeType = 6
+	LastAliveReceivedGenTimestampProducerChangeType    ProducerChangeType = 7
+)
+
+// ProducerChange describes which attribute of the producer changed, Producer is the state after the change
+type ProducerChange struct {
+	Type     ProducerChangeType
+	Producer Producer
+}
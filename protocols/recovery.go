@@ -1,5 +1,6 @@
 package protocols
 
+import "context"
 import "time"
 import "github.com/google/uuid"
 
@@ -7,12 +8,30 @@ import "github.com/google/uuid"
 type RecoveryMessage struct {
 	ProducerStatus       ProducerStatus
 	EventRecoveryMessage EventRecoveryMessage
+	SyntheticBetStop     SyntheticBetStop
+	SyntheticBetStart    SyntheticBetStart
 }
 
 // RecoveryManager ...
 type RecoveryManager interface {
 	InitiateEventOddsMessagesRecovery(producerID uint, eventID URN) (uint, error)
 	InitiateEventStatefulMessagesRecovery(producerID uint, eventID URN) (uint, error)
+	// InitiateBulkEventRecovery requests recovery of all events in the background
+	InitiateBulkEventRecovery(request BulkEventRecoveryRequest) (BulkEventRecovery, error)
+	// Status returns recovery state of all known producers by producer id
+	Status() map[uint]ProducerRecoveryStatus
+	// History returns recorded producer status changes and recovery attempts from the oldest, of all producers
+	// when no producer id is given
+	History(producerIDs ...uint) []RecoveryHistoryEntry
+	// InitiateSnapshotRecovery marks the producer down and requests its recovery from after, full recovery is requested
+	// when after is zero. Recovery in progress is cancelled.
+	InitiateSnapshotRecovery(producerID uint, after time.Time) (uint, error)
+	// CancelSnapshotRecovery stops waiting for recovery in progress, the producer is marked down as by MarkProducerDown
+	// so no recovery is requested until InitiateSnapshotRecovery is called
+	CancelSnapshotRecovery(producerID uint) error
+	// MarkProducerDown marks the producer down until InitiateSnapshotRecovery is called
+	MarkProducerDown(producerID uint) error
+	WithContext(ctx context.Context) RecoveryManager
 }
 
 // RecoveryMessageProcessor ...
@@ -21,6 +40,7 @@ type RecoveryMessageProcessor interface {
 	OnMessageProcessingEnded(sessionID uuid.UUID, producerID uint, timestamp time.Time)
 	OnAliveReceived(producerID uint, timestamp MessageTimestamp, isSubscribed bool, messageInterest MessageInterest)
 	OnSnapshotCompleteReceived(producerID uint, requestID uint, messageInterest MessageInterest)
+	OnEventMessageReceived(producerID uint, eventID URN, sportID *URN)
 }
 
 // RecoveryState ...
@@ -48,6 +68,7 @@ const (
 	AliveIntervalViolationProducerStatusReason         ProducerStatusReason = 4
 	ProcessingQueueDelayViolationProducerStatusReason  ProducerStatusReason = 5
 	OtherProducerStatusReason                          ProducerStatusReason = 6
+	ManualProducerStatusReason                         ProducerStatusReason = 7
 )
 
 // ProducerDownReason ...
@@ -59,6 +80,7 @@ const (
 	AliveInternalViolationProducerDownReason        ProducerDownReason = 1
 	ProcessingQueueDelayViolationProducerDownReason ProducerDownReason = 2
 	OtherProducerDownReason                         ProducerDownReason = 6
+	ManualProducerDownReason                        ProducerDownReason = 7
 )
 
 // ToProducerStatusReason ...
@@ -70,6 +92,8 @@ func (p ProducerDownReason) ToProducerStatusReason() ProducerStatusReason {
 		return ProcessingQueueDelayViolationProducerStatusReason
 	case OtherProducerDownReason:
 		return OtherProducerStatusReason
+	case ManualProducerDownReason:
+		return ManualProducerStatusReason
 	default:
 		return ErrorProducerStatusReason
 	}
@@ -0,0 +1,26 @@
+package protocols
+
+import "time"
+
+// RecoveryResult describes snapshot recovery finished by one of the nodes
+type RecoveryResult struct {
+	NodeID     *int
+	RequestID  uint
+	StartedAt  time.Time
+	FinishedAt time.Time
+}
+
+// RecoveryCoordinator makes sure only one of the clients sharing the bookmaker account requests snapshot
+// recovery of the producer at a time. Owner identifies the client instance. Recoveries are only serialized, not
+// deduplicated - the result is reused only by clients with the same node id, clients with other node ids request
+// their own recovery once the lock is released.
+type RecoveryCoordinator interface {
+	// AcquireRecovery returns true when owner can request recovery of the producer, the lock is also granted when
+	// the owner already holds it. Lock expires ttl after now, which comes from the clock of the feed, unless it is
+	// released sooner.
+	AcquireRecovery(producerID uint, owner string, now time.Time, ttl time.Duration) (bool, error)
+	// ReleaseRecovery releases the lock, result is nil when recovery failed
+	ReleaseRecovery(producerID uint, owner string, result *RecoveryResult) error
+	// LastRecovery returns the last recovery of the producer finished by any owner, nil when there is none
+	LastRecovery(producerID uint) (*RecoveryResult, error)
+}
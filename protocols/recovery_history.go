@@ -0,0 +1,37 @@
+package protocols
+
+import "time"
+
+// RecoveryHistoryEntryType ...
+type RecoveryHistoryEntryType int
+
+// RecoveryHistoryEntryTypes
+const (
+	ProducerDownRecoveryHistoryEntryType        RecoveryHistoryEntryType = 1
+	ProducerUpRecoveryHistoryEntryType          RecoveryHistoryEntryType = 2
+	RecoveryStartedRecoveryHistoryEntryType     RecoveryHistoryEntryType = 3
+	RecoveryCompletedRecoveryHistoryEntryType   RecoveryHistoryEntryType = 4
+	RecoveryFailedRecoveryHistoryEntryType      RecoveryHistoryEntryType = 5
+	RecoveryInterruptedRecoveryHistoryEntryType RecoveryHistoryEntryType = 6
+)
+
+// RecoveryHistoryEntry records producer status change or snapshot recovery attempt
+type RecoveryHistoryEntry struct {
+	Type       RecoveryHistoryEntryType
+	ProducerID uint
+	Timestamp  time.Time
+	// Reason is set for producer status changes
+	Reason ProducerStatusReason
+	// RequestID and After are set for recovery attempts, After is zero for full recovery
+	RequestID uint
+	After     time.Time
+	// Duration is time the producer was down for producer up and time of recovery for completed recovery
+	Duration time.Duration
+	// Error describes why the recovery failed
+	Error string
+}
+
+// RecoveryHistorySink receives every history entry, it is called synchronously so it should not block
+type RecoveryHistorySink interface {
+	Record(entry RecoveryHistoryEntry)
+}
@@ -0,0 +1,25 @@
+package protocols
+
+import "time"
+
+// ProducerRecoveryStatus is a snapshot of recovery state of the producer
+type ProducerRecoveryStatus struct {
+	ProducerID    uint
+	RecoveryState RecoveryState
+	// RecoveryRequestID is request id of the last snapshot recovery, zero when none was requested
+	RecoveryRequestID                uint
+	RecoveryStartedAt                time.Time
+	ProducerDownReason               ProducerDownReason
+	LastSystemAliveReceivedAt        time.Time
+	LastUserSessionAliveReceivedAt   time.Time
+	LastProcessedMessageGenTimestamp time.Time
+	PendingEventRecoveries           []PendingEventRecovery
+	FirstRecoveryCompleted           bool
+}
+
+// PendingEventRecovery ...
+type PendingEventRecovery struct {
+	EventID   URN
+	RequestID uint
+	StartedAt time.Time
+}
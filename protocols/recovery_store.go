@@ -0,0 +1,28 @@
+package protocols
+
+import "time"
+
+// ProducerRecoveryState is recovery state of the producer kept across restarts
+type ProducerRecoveryState struct {
+	ProducerID                       uint
+	LastProcessedMessageGenTimestamp time.Time
+	// RecoveryFromTimestamp is used for the first recovery after restart
+	RecoveryFromTimestamp time.Time
+	LastRecoveryInfo      *RecoveryInfoState
+}
+
+// RecoveryInfoState is persisted RecoveryInfo
+type RecoveryInfoState struct {
+	After      time.Time
+	Timestamp  time.Time
+	RequestID  uint
+	Successful bool
+	NodeID     *int
+}
+
+// RecoveryStateStore persists recovery state of producers, state is loaded on feed open and saved periodically
+// while messages are processed and on feed close
+type RecoveryStateStore interface {
+	Load() (map[uint]ProducerRecoveryState, error)
+	Save(states map[uint]ProducerRecoveryState) error
+}
@@ -1,5 +1,7 @@
 package protocols
 
+import "context"
+
 // ReplayPlayParams ...
 type ReplayPlayParams struct {
 	Speed             *int
@@ -23,4 +25,6 @@ type ReplayManager interface {
 
 	Stop() (bool, error)
 	Clear() (bool, error)
+
+	WithContext(ctx context.Context) ReplayManager
 }
@@ -1,6 +1,9 @@
 package protocols
 
-import "time"
+import (
+	"context"
+	"time"
+)
 
 // SportsInfoManager ...
 type SportsInfoManager interface {
@@ -37,4 +40,6 @@ type SportsInfoManager interface {
 	ClearMatch(id URN)
 	ClearTournament(id URN)
 	ClearCompetitor(id URN)
+
+	WithContext(ctx context.Context) SportsInfoManager
 }
@@ -0,0 +1,23 @@
+package protocols
+
+// SyntheticBetStop is generated by the SDK for every active event of the producer which went down, it is not sent
+// by the feed. All markets of the event should be suspended.
+type SyntheticBetStop interface {
+	BetStop
+	// IsSDKGenerated is always true
+	IsSDKGenerated() bool
+	// Reason why the producer went down
+	Reason() ProducerStatusReason
+}
+
+// SyntheticBetStart is generated by the SDK for every event suspended by SyntheticBetStop once the producer is up
+// after recovery. It can be delivered before the application consumed odds changes of the recovery from its
+// sessions, odds are current once those are processed.
+type SyntheticBetStart interface {
+	Message
+	EventMessage
+	// IsSDKGenerated is always true
+	IsSDKGenerated() bool
+	// Reason why the producer went up
+	Reason() ProducerStatusReason
+}
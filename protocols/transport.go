@@ -0,0 +1,51 @@
+package protocols
+
+import "time"
+
+// Acknowledger can also implement Redelivered() bool, messages failed by a session listener are then never
+// requeued twice
+type Acknowledger interface {
+	Ack() error
+	Nack(requeue bool) error
+}
+
+// TransportDelivery ...
+type TransportDelivery struct {
+	RoutingKey string
+	Body       []byte
+	Timestamp  time.Time
+	// Acknowledger is nil when delivery is acknowledged automatically
+	Acknowledger Acknowledger
+}
+
+// ChannelOptions ...
+type ChannelOptions struct {
+	// QueueName of durable queue, anonymous exclusive queue is declared when empty
+	QueueName     string
+	ManualAck     bool
+	PrefetchCount int
+}
+
+// TransportChannel ...
+type TransportChannel interface {
+	Deliveries() <-chan TransportDelivery
+	// Bind adds routing key to the channel queue
+	Bind(routingKey string) error
+	// Unbind removes routing key from the channel queue
+	Unbind(routingKey string) error
+	Close() error
+}
+
+// Transport delivers feed messages to sessions, AMQP is used when no transport is configured
+type Transport interface {
+	Open() error
+	CreateChannel(exchangeName string, routingKeys []string, options ChannelOptions) (TransportChannel, error)
+	ConnectionStatusCh() <-chan ConnectionStatus
+	Close()
+}
+
+// InMemoryTransport is a broker-less transport, published deliveries are routed by topic routing keys
+type InMemoryTransport interface {
+	Transport
+	Publish(exchangeName string, delivery TransportDelivery) error
+}
@@ -0,0 +1,22 @@
+package gosdk
+
+import (
+	"github.com/oddin-gg/gosdk/internal/recovery"
+	"github.com/oddin-gg/gosdk/protocols"
+)
+
+// NewFileRecoveryStateStore creates store which keeps recovery state of producers in json file at path
+func NewFileRecoveryStateStore(path string) protocols.RecoveryStateStore {
+	return recovery.NewFileStateStore(path)
+}
+
+// NewInMemoryRecoveryCoordinator creates coordinator of feeds running in the same process
+func NewInMemoryRecoveryCoordinator() protocols.RecoveryCoordinator {
+	return recovery.NewInMemoryCoordinator()
+}
+
+// NewFileRecoveryCoordinator creates coordinator of feeds running on the same host, directory has to exist
+// and support advisory file locks
+func NewFileRecoveryCoordinator(directory string) protocols.RecoveryCoordinator {
+	return recovery.NewFileCoordinator(directory)
+}
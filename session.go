@@ -1,7 +1,13 @@
 package gosdk
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
@@ -9,6 +15,7 @@ import (
 	"github.com/oddin-gg/gosdk/internal/factory"
 	"github.com/oddin-gg/gosdk/internal/feed"
 	feedXML "github.com/oddin-gg/gosdk/internal/feed/xml"
+	"github.com/oddin-gg/gosdk/internal/journal"
 	"github.com/oddin-gg/gosdk/internal/producer"
 	"github.com/oddin-gg/gosdk/protocols"
 	log "github.com/sirupsen/logrus"
@@ -17,15 +24,18 @@ import (
 type sdkOddsFeedSession interface {
 	protocols.OddsFeedSession
 	Open(
+		ctx context.Context,
 		routingKeys []string,
 		messageInterest *protocols.MessageInterest,
 		reportExtendedData bool,
 	) error
 	Close()
 	IsReplay() bool
+	connectionRestored()
 }
 
 type oddsFeedSessionImpl struct {
+	cfg                      protocols.OddsFeedConfiguration
 	channelConsumer          *feed.ChannelConsumer
 	producerManager          *producer.Manager
 	cacheManager             *cache.Manager
@@ -34,12 +44,38 @@ type oddsFeedSessionImpl struct {
 	exchangeName             string
 	sportIDPrefix            string
 	sessionID                uuid.UUID
+	ctx                      context.Context
 	logger                   *log.Entry
+	options                  sessionOptions
+	deduplicator             *deduplicator
+	tournamentFilter         *tournamentFilter
+	sessionFilter            *sessionFilter
+	buildMessage             protocols.MessageHandler
+	messageInterest          *protocols.MessageInterest
+	listener                 protocols.OddsFeedListener
+	listenerErrors           atomic.Uint64
+	subscriptions            []subscription
 	closeCh                  chan bool
+	workersWg                sync.WaitGroup
+	pending                  sync.WaitGroup
 	msgCh                    chan protocols.SessionMessage
 	isReplay                 bool
 }
 
+type sessionOptions struct {
+	workers             int
+	overflow            protocols.OverflowParams
+	deduplicationWindow time.Duration
+	tournamentIDs       map[protocols.URN]struct{}
+	listener            protocols.OddsFeedListener
+	middlewares         []protocols.MessageMiddleware
+	filter              *protocols.SessionFilter
+	// requeueOnListenerError returns messages failed by listener to the queue once
+	requeueOnListenerError bool
+	// index is the order in which the session was built, journal records of the session carry it
+	index int
+}
+
 func (o *oddsFeedSessionImpl) RespCh() protocols.SessionMessageDelivery {
 	return o.msgCh
 }
@@ -48,7 +84,9 @@ func (o *oddsFeedSessionImpl) IsReplay() bool {
 	return o.isReplay
 }
 
+// Open starts consuming messages, ctx is used for API calls made while processing them
 func (o *oddsFeedSessionImpl) Open(
+	ctx context.Context,
 	routingKeys []string,
 	messageInterest *protocols.MessageInterest,
 	reportExtendedData bool) error {
@@ -56,23 +94,51 @@ func (o *oddsFeedSessionImpl) Open(
 		return errors.New("session is already opened")
 	}
 
-	ch, err := o.channelConsumer.Open(routingKeys, messageInterest)
+	ch, err := o.channelConsumer.Open(routingKeys, messageInterest, o.channelOptions(*messageInterest))
 	if err != nil {
 		return err
 	}
 
-	o.closeCh = make(chan bool, 1)
+	o.closeCh = make(chan bool)
+	o.ctx = ctx
+	o.messageInterest = messageInterest
+	o.feedMessageFactory = o.feedMessageFactory.WithContext(ctx)
+	o.buildMessage = o.messageHandler()
+
+	if o.options.workers <= 1 {
+		o.startWorker(ch, o.sessionID, messageInterest, reportExtendedData, nil)
+		return nil
+	}
+
+	// Messages are sharded by event so every event is still processed in order
+	shards := make([]chan *protocols.QueueMessage, o.options.workers)
+	for i := range shards {
+		shards[i] = make(chan *protocols.QueueMessage)
+		processingID := uuid.NewSHA1(o.sessionID, []byte(strconv.Itoa(i)))
+		o.startWorker(shards[i], processingID, messageInterest, reportExtendedData, &o.pending)
+	}
 
-	go func(messageInterest *protocols.MessageInterest) {
+	go func() {
 		for {
 			select {
 			case <-o.closeCh:
 				return
 			case msg := <-ch:
-				o.processMessage(msg, messageInterest, reportExtendedData)
+				// Recovery has to see every snapshot message before its snapshot_complete
+				if isSnapshotComplete(msg) {
+					o.pending.Wait()
+				}
+
+				o.pending.Add(1)
+				select {
+				case shards[o.shardIndex(msg)] <- msg:
+				case <-o.closeCh:
+					o.pending.Done()
+					return
+				}
 			}
 		}
-	}(messageInterest)
+	}()
 
 	return nil
 }
@@ -80,36 +146,248 @@ func (o *oddsFeedSessionImpl) Open(
 func (o *oddsFeedSessionImpl) Close() {
 	o.cacheManager.Close()
 	o.channelConsumer.Close()
+
+	if o.closeCh != nil {
+		close(o.closeCh)
+		o.workersWg.Wait()
+	}
+
 	if o.msgCh != nil {
 		close(o.msgCh)
 	}
 
-	if o.closeCh != nil {
-		o.closeCh <- true
+	for _, subscription := range o.subscriptions {
+		subscription.close()
 	}
+	o.subscriptions = nil
 
 	o.closeCh = nil
 }
 
+// connectionRestored lets channel of the session reconnect as soon as transport connection is back
+func (o *oddsFeedSessionImpl) connectionRestored() {
+	o.channelConsumer.ConnectionRestored()
+}
+
+func (o *oddsFeedSessionImpl) startWorker(
+	ch <-chan *protocols.QueueMessage,
+	processingID uuid.UUID,
+	messageInterest *protocols.MessageInterest,
+	reportExtendedData bool,
+	pending *sync.WaitGroup,
+) {
+	o.workersWg.Add(1)
+	go func() {
+		defer o.workersWg.Done()
+
+		for {
+			select {
+			case <-o.closeCh:
+				return
+			case msg := <-ch:
+				o.processMessage(msg, processingID, messageInterest, reportExtendedData)
+				if pending != nil {
+					pending.Done()
+				}
+			}
+		}
+	}()
+}
+
+// shardIndex assigns system and unparsable messages to the first shard, snapshot_complete is dispatched
+// only after all shards processed preceding messages
+func (o *oddsFeedSessionImpl) shardIndex(msg *protocols.QueueMessage) int {
+	if msg.FeedMessage == nil || msg.FeedMessage.RoutingKey == nil || msg.FeedMessage.RoutingKey.EventID == nil {
+		return 0
+	}
+
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(msg.FeedMessage.RoutingKey.EventID.ToString()))
+	return int(hash.Sum32() % uint32(o.options.workers))
+}
+
+func isSnapshotComplete(msg *protocols.QueueMessage) bool {
+	if msg.FeedMessage == nil {
+		return false
+	}
+
+	_, ok := msg.FeedMessage.Message.(*feedXML.SnapshotComplete)
+	return ok
+}
+
+func (o *oddsFeedSessionImpl) ListenerErrors() uint64 {
+	return o.listenerErrors.Load()
+}
+
+func (o *oddsFeedSessionImpl) subscribe(subscription subscription) error {
+	if o.closeCh != nil {
+		return errors.New("session is already opened")
+	}
+
+	o.subscriptions = append(o.subscriptions, subscription)
+	return nil
+}
+
+func (o *oddsFeedSessionImpl) deliver(msg protocols.SessionMessage) {
+	if msg.Message != nil {
+		for _, subscription := range o.subscriptions {
+			if subscription.accepts(msg.Message) {
+				subscription.deliver(msg, o.closeCh)
+				return
+			}
+		}
+	}
+
+	if o.listener != nil {
+		o.notifyListener(msg)
+		return
+	}
+
+	select {
+	case o.msgCh <- msg:
+	case <-o.closeCh:
+	}
+}
+
+// notifyListener acknowledges the message once listener processed it, failed message is returned to the broker
+// to be delivered again
+func (o *oddsFeedSessionImpl) notifyListener(msg protocols.SessionMessage) {
+	err := dispatchSessionMessage(o.listener, msg)
+	if err != nil {
+		o.listenerErrors.Add(1)
+		o.logger.WithError(err).Error("session listener failed to process message")
+		o.nack(msg.Acknowledger)
+		return
+	}
+
+	o.ack(msg.Acknowledger)
+}
+
 func (o *oddsFeedSessionImpl) ID() uuid.UUID {
 	return o.sessionID
 }
 
-func (o *oddsFeedSessionImpl) processMessage(msg *protocols.QueueMessage, messageInterest *protocols.MessageInterest, reportExtendedData bool) {
+func (o *oddsFeedSessionImpl) AddEvent(eventID protocols.URN) error {
+	err := o.checkEventsUpdatable()
+	if err != nil {
+		return err
+	}
+
+	return o.channelConsumer.Bind(nodeRoutingKeys(o.cfg.SdkNodeID(), eventRoutingKey(eventID)))
+}
+
+func (o *oddsFeedSessionImpl) AddEventWithRecovery(eventID protocols.URN, producerID uint) (uint, error) {
+	recoveryManager, ok := o.recoveryMessageProcessor.(protocols.RecoveryManager)
+	if !ok {
+		return 0, errors.New("session does not support recovery")
+	}
+
+	err := o.AddEvent(eventID)
+	if err != nil {
+		return 0, err
+	}
+
+	return recoveryManager.InitiateEventOddsMessagesRecovery(producerID, eventID)
+}
+
+func (o *oddsFeedSessionImpl) RemoveEvent(eventID protocols.URN) error {
+	err := o.checkEventsUpdatable()
+	if err != nil {
+		return err
+	}
+
+	return o.channelConsumer.Unbind(nodeRoutingKeys(o.cfg.SdkNodeID(), eventRoutingKey(eventID)))
+}
+
+func (o *oddsFeedSessionImpl) checkEventsUpdatable() error {
+	switch {
+	case o.messageInterest == nil:
+		return errors.New("session is not opened")
+	case *o.messageInterest != protocols.SpecifiedMatchesOnlyMessageInterest:
+		return errors.New("events can be changed only in session with specified matches message interest")
+	}
+
+	return nil
+}
+
+func (o *oddsFeedSessionImpl) channelOptions(messageInterest protocols.MessageInterest) protocols.ChannelOptions {
+	queueOptions := o.cfg.QueueOptions()
+	return protocols.ChannelOptions{
+		QueueName:     queueName(o.cfg, messageInterest, o.isReplay),
+		ManualAck:     queueOptions.ManualAck,
+		PrefetchCount: queueOptions.PrefetchCount,
+	}
+}
+
+// queueName returns name of the durable queue of the session, it is empty when sessions use anonymous queues
+func queueName(cfg protocols.OddsFeedConfiguration, messageInterest protocols.MessageInterest, isReplay bool) string {
+	prefix := cfg.QueueOptions().NamePrefix
+	if len(prefix) == 0 {
+		return ""
+	}
+
+	name := messageInterest.Name()
+	if isReplay {
+		name = "replay"
+	}
+
+	return fmt.Sprintf("%s.%s", prefix, name)
+}
+
+func (o *oddsFeedSessionImpl) ack(acknowledger protocols.Acknowledger) {
+	if acknowledger == nil {
+		return
+	}
+
+	err := acknowledger.Ack()
+	if err != nil {
+		o.logger.WithError(err).Error("failed to ack message")
+	}
+}
+
+// redelivery is implemented by acknowledgers of transports which know the message was delivered before
+type redelivery interface {
+	Redelivered() bool
+}
+
+func (o *oddsFeedSessionImpl) nack(acknowledger protocols.Acknowledger) {
+	if acknowledger == nil {
+		return
+	}
+
+	requeue := o.options.requeueOnListenerError
+	if r, ok := acknowledger.(redelivery); ok && r.Redelivered() {
+		requeue = false
+	}
+
+	err := acknowledger.Nack(requeue)
+	if err != nil {
+		o.logger.WithError(err).Error("failed to nack message")
+	}
+}
+
+func (o *oddsFeedSessionImpl) processMessage(
+	msg *protocols.QueueMessage,
+	processingID uuid.UUID,
+	messageInterest *protocols.MessageInterest,
+	reportExtendedData bool,
+) {
 	if msg.UnparsableMessage != nil {
-		o.msgCh <- protocols.SessionMessage{
+		o.deliver(protocols.SessionMessage{
 			UnparsableMessage: msg.UnparsableMessage,
-		}
+			Acknowledger:      msg.Acknowledger,
+		})
 		return
 	}
 
 	if msg.RawFeedMessage != nil && reportExtendedData {
-		o.msgCh <- protocols.SessionMessage{
+		o.deliver(protocols.SessionMessage{
 			RawFeedMessage: msg.RawFeedMessage,
-		}
+		})
 	}
 
 	if msg.FeedMessage == nil {
+		o.ack(msg.Acknowledger)
 		return
 	}
 
@@ -123,87 +401,170 @@ func (o *oddsFeedSessionImpl) processMessage(msg *protocols.QueueMessage, messag
 	switch {
 	case err != nil:
 		o.logger.WithError(err).Errorf("failed to check if producer is enabled %d", producerID)
-	case !isProducerEnabled:
+	case !isProducerEnabled, !messageInterest.IsProducerInScope(producerData):
+		o.ack(msg.Acknowledger)
 		return
-	case !messageInterest.IsProducerInScope(producerData):
+	}
+
+	if o.tournamentFilter != nil && !o.tournamentFilter.isInScope(o.ctx, msg.FeedMessage.RoutingKey.EventID) {
+		o.ack(msg.Acknowledger)
 		return
 	}
 
-	o.processFeedMessage(msg.FeedMessage, *messageInterest)
+	if o.sessionFilter != nil && !o.sessionFilter.isEventInScope(o.ctx, msg.FeedMessage.RoutingKey.EventID) {
+		o.ack(msg.Acknowledger)
+		return
+	}
 
+	o.processFeedMessage(msg.FeedMessage, processingID, *messageInterest, msg.Acknowledger)
 }
 
-func (o *oddsFeedSessionImpl) processFeedMessage(feedMessage *protocols.FeedMessage, messageInterest protocols.MessageInterest) {
+func (o *oddsFeedSessionImpl) processFeedMessage(
+	feedMessage *protocols.FeedMessage,
+	processingID uuid.UUID,
+	messageInterest protocols.MessageInterest,
+	acknowledger protocols.Acknowledger,
+) {
 	producerID := feedMessage.Message.Product()
-	o.recoveryMessageProcessor.OnMessageProcessingStarted(o.sessionID, producerID, time.Now())
+	o.recoveryMessageProcessor.OnMessageProcessingStarted(processingID, producerID, o.cfg.Clock().Now())
 
 	o.cacheManager.OnFeedMessageReceived(feedMessage)
 
 	switch msg := feedMessage.Message.(type) {
 	case *feedXML.Alive:
 		o.recoveryMessageProcessor.OnAliveReceived(producerID, feedMessage.Timestamp, msg.Subscribed == 1, messageInterest)
-		o.recoveryMessageProcessor.OnMessageProcessingEnded(o.sessionID, producerID, feedMessage.Timestamp.Created)
+		o.recoveryMessageProcessor.OnMessageProcessingEnded(processingID, producerID, feedMessage.Timestamp.Created)
+		o.ack(acknowledger)
 		return
 	case *feedXML.SnapshotComplete:
 		o.recoveryMessageProcessor.OnSnapshotCompleteReceived(producerID, msg.RequestID, messageInterest)
-		o.recoveryMessageProcessor.OnMessageProcessingEnded(o.sessionID, producerID, time.Time{})
+		o.recoveryMessageProcessor.OnMessageProcessingEnded(processingID, producerID, time.Time{})
+		o.ack(acknowledger)
 		return
 	}
 
-	message, err := o.feedMessageFactory.BuildMessage(feedMessage)
-	if err != nil {
+	if o.deduplicator != nil && o.deduplicator.isDuplicate(feedMessage) {
+		o.recoveryMessageProcessor.OnMessageProcessingEnded(processingID, producerID, time.Time{})
+		o.ack(acknowledger)
+		return
+	}
+
+	if o.sessionFilter != nil {
+		trimmed, ok := o.sessionFilter.trimMarkets(o.ctx, feedMessage.Message)
+		if !ok {
+			o.recoveryMessageProcessor.OnMessageProcessingEnded(processingID, producerID, time.Time{})
+			o.ack(acknowledger)
+			return
+		}
+
+		feedMessage.Message = trimmed
+	}
+
+	message, err := o.buildMessage(feedMessage)
+	switch {
+	case err != nil:
 		o.logger.WithError(err).Errorf("failed to build message from feed message %v", feedMessage)
 		unparsableMsg := o.feedMessageFactory.BuildUnparsableMessage(feedMessage)
-		o.msgCh <- protocols.SessionMessage{
+		o.deliver(protocols.SessionMessage{
 			UnparsableMessage: unparsableMsg,
-		}
+			Acknowledger:      acknowledger,
+		})
 		return
+	case message == nil:
+		// Dropped by middleware
+		o.recoveryMessageProcessor.OnMessageProcessingEnded(processingID, producerID, time.Time{})
+		o.ack(acknowledger)
+		return
+	}
+
+	var fromRecovery bool
+	if requestMessage, ok := message.(protocols.RequestMessage); ok {
+		fromRecovery = requestMessage.RequestID() != nil
 	}
 
 	var timestamp time.Time
 	switch msg := message.(type) {
 	case protocols.OddsChange:
 		timestamp = msg.Timestamp().Created
-		o.msgCh <- protocols.SessionMessage{
-			Message: msg,
-		}
+		o.trackEvent(producerID, feedMessage)
+		o.deliver(protocols.SessionMessage{
+			Message:      msg,
+			Acknowledger: acknowledger,
+			FromRecovery: fromRecovery,
+		})
 	case protocols.BetStop:
 		timestamp = msg.Timestamp().Created
-		o.msgCh <- protocols.SessionMessage{
-			Message: msg,
-		}
+		o.trackEvent(producerID, feedMessage)
+		o.deliver(protocols.SessionMessage{
+			Message:      msg,
+			Acknowledger: acknowledger,
+			FromRecovery: fromRecovery,
+		})
 	case protocols.BetCancel:
-		o.msgCh <- protocols.SessionMessage{
-			Message: msg,
-		}
+		o.deliver(protocols.SessionMessage{
+			Message:      msg,
+			Acknowledger: acknowledger,
+			FromRecovery: fromRecovery,
+		})
 	case protocols.BetSettlement:
-		o.msgCh <- protocols.SessionMessage{
-			Message: msg,
-		}
+		o.deliver(protocols.SessionMessage{
+			Message:      msg,
+			Acknowledger: acknowledger,
+			FromRecovery: fromRecovery,
+		})
 	case protocols.FixtureChangeMessage:
-		o.msgCh <- protocols.SessionMessage{
-			Message: msg,
-		}
+		o.deliver(protocols.SessionMessage{
+			Message:      msg,
+			Acknowledger: acknowledger,
+			FromRecovery: fromRecovery,
+		})
 	case protocols.RollbackBetSettlement:
-		o.msgCh <- protocols.SessionMessage{
-			Message: msg,
-		}
+		o.deliver(protocols.SessionMessage{
+			Message:      msg,
+			Acknowledger: acknowledger,
+			FromRecovery: fromRecovery,
+		})
 	case protocols.RollbackBetCancel:
-		o.msgCh <- protocols.SessionMessage{
-			Message: msg,
-		}
+		o.deliver(protocols.SessionMessage{
+			Message:      msg,
+			Acknowledger: acknowledger,
+			FromRecovery: fromRecovery,
+		})
 	default:
 		unparsableMsg := o.feedMessageFactory.BuildUnparsableMessage(feedMessage)
-		o.msgCh <- protocols.SessionMessage{
+		o.deliver(protocols.SessionMessage{
 			UnparsableMessage: unparsableMsg,
-		}
+			Acknowledger:      acknowledger,
+		})
+	}
+
+	o.recoveryMessageProcessor.OnMessageProcessingEnded(processingID, producerID, timestamp)
+}
+
+// messageHandler wraps building of messages with session middlewares
+func (o *oddsFeedSessionImpl) messageHandler() protocols.MessageHandler {
+	// Middlewares are applied in reverse, so the first one sees the message first
+	buildMessage := protocols.MessageHandler(o.feedMessageFactory.BuildMessage)
+	for i := len(o.options.middlewares) - 1; i >= 0; i-- {
+		buildMessage = o.options.middlewares[i](buildMessage)
 	}
 
-	o.recoveryMessageProcessor.OnMessageProcessingEnded(o.sessionID, producerID, timestamp)
+	return buildMessage
+}
+
+// trackEvent marks the event as active for synthetic suspension when its producer goes down
+func (o *oddsFeedSessionImpl) trackEvent(producerID uint, feedMessage *protocols.FeedMessage) {
+	if feedMessage.RoutingKey == nil || feedMessage.RoutingKey.EventID == nil {
+		return
+	}
+
+	o.recoveryMessageProcessor.OnEventMessageReceived(producerID, *feedMessage.RoutingKey.EventID, feedMessage.RoutingKey.SportID)
 }
 
 func newSession(
-	rabbitMQClient *feed.Client,
+	cfg protocols.OddsFeedConfiguration,
+	transport protocols.Transport,
+	journal *journal.Writer,
 	producerManager *producer.Manager,
 	cacheManager *cache.Manager,
 	feedMessageFactory *factory.FeedMessageFactory,
@@ -211,15 +572,36 @@ func newSession(
 	exchangeName string,
 	sportIDPrefix string,
 	isReplay bool,
+	options sessionOptions,
 	logger *log.Entry,
 ) sdkOddsFeedSession {
+	var deduplicator *deduplicator
+	if options.deduplicationWindow > 0 {
+		deduplicator = newDeduplicator(options.deduplicationWindow)
+	}
+
+	var tournamentFilter *tournamentFilter
+	if len(options.tournamentIDs) != 0 {
+		tournamentFilter = newTournamentFilter(options.tournamentIDs, cacheManager.MatchCache, cfg.DefaultLocale(), logger)
+	}
+
+	var sessionFilter *sessionFilter
+	if options.filter != nil {
+		sessionFilter = newSessionFilter(*options.filter, cacheManager, cfg.DefaultLocale(), logger)
+	}
+
 	return &oddsFeedSessionImpl{
+		cfg: cfg,
 		channelConsumer: feed.NewChannelConsumer(
-			rabbitMQClient,
+			transport,
+			journal,
+			options.index,
 			feedMessageFactory,
 			logger,
 			exchangeName,
 			sportIDPrefix,
+			options.overflow,
+			cfg.Clock(),
 		),
 		producerManager:          producerManager,
 		cacheManager:             cacheManager,
@@ -228,8 +610,14 @@ func newSession(
 		exchangeName:             exchangeName,
 		sportIDPrefix:            sportIDPrefix,
 		sessionID:                uuid.New(),
+		ctx:                      context.Background(),
 		isReplay:                 isReplay,
+		options:                  options,
+		deduplicator:             deduplicator,
+		tournamentFilter:         tournamentFilter,
+		sessionFilter:            sessionFilter,
+		listener:                 options.listener,
 		logger:                   logger,
-		msgCh:                    make(chan protocols.SessionMessage),
+		msgCh:                    make(chan protocols.SessionMessage, cfg.ChannelBufferSize()),
 	}
 }
@@ -0,0 +1,212 @@
+package gosdk
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/oddin-gg/gosdk/internal/cache"
+	feedXML "github.com/oddin-gg/gosdk/internal/feed/xml"
+	"github.com/oddin-gg/gosdk/protocols"
+	gocache "github.com/patrickmn/go-cache"
+	log "github.com/sirupsen/logrus"
+)
+
+// sessionFilter applies protocols.SessionFilter, values which cannot be resolved via API pass the filter
+// and are not requested again for unresolvedLookupTTL
+type sessionFilter struct {
+	filter       protocols.SessionFilter
+	cacheManager *cache.Manager
+	locale       protocols.Locale
+	failures     *gocache.Cache
+	logger       *log.Entry
+}
+
+// isEventInScope checks risk tier and sport format of the event
+func (s *sessionFilter) isEventInScope(ctx context.Context, eventID *protocols.URN) bool {
+	if eventID == nil {
+		return true
+	}
+
+	var tournamentID *protocols.URN
+	switch eventID.Type {
+	case string(protocols.TournamentEventType):
+		tournamentID = eventID
+	case string(protocols.MatchEventType):
+		sportFormatKey := "sport_format:" + eventID.ToString()
+		if len(s.filter.SportFormats) != 0 && !s.failed(sportFormatKey) {
+			sportFormat, err := s.cacheManager.MatchCache.SportFormat(ctx, *eventID, s.locale)
+			switch {
+			case err != nil:
+				s.recordFailure(sportFormatKey, err)
+			case !slices.Contains(s.filter.SportFormats, sportFormat):
+				return false
+			}
+		}
+
+		tournamentKey := "tournament:" + eventID.ToString()
+		if s.filter.MaxRiskTier == nil || s.failed(tournamentKey) {
+			return true
+		}
+
+		var err error
+		tournamentID, err = s.cacheManager.MatchCache.TournamentID(ctx, *eventID, s.locale)
+		if err != nil {
+			s.recordFailure(tournamentKey, err)
+			return true
+		}
+	default:
+		return true
+	}
+
+	riskTierKey := "risk_tier:" + tournamentID.ToString()
+	if s.filter.MaxRiskTier == nil || s.failed(riskTierKey) {
+		return true
+	}
+
+	riskTier, err := s.cacheManager.TournamentCache.RiskTier(ctx, *tournamentID, s.locale)
+	if err != nil {
+		s.recordFailure(riskTierKey, err)
+		return true
+	}
+
+	// MaxRiskTier is inclusive
+	return riskTier <= *s.filter.MaxRiskTier
+}
+
+// trimMarkets returns copy of the message without filtered markets, the parsed message is shared with raw feed
+// message so it is never modified. False is returned when message without markets should not be delivered
+func (s *sessionFilter) trimMarkets(ctx context.Context, message protocols.BasicMessage) (protocols.BasicMessage, bool) {
+	if len(s.filter.MarketIDs) == 0 && len(s.filter.MarketVariants) == 0 && len(s.filter.MarketGroups) == 0 {
+		return message, true
+	}
+
+	switch msg := message.(type) {
+	case *feedXML.OddsChange:
+		// Odds change without markets still carries event status
+		trimmed := *msg
+		trimmed.Odds.Markets = keepMarkets(msg.Odds.Markets, func(market *feedXML.MarketWithOutcome) bool {
+			return s.isMarketInScope(ctx, market.MarketAttributes)
+		})
+		return &trimmed, true
+	case *feedXML.BetSettlement:
+		trimmed := *msg
+		trimmed.Markets.Markets = keepMarkets(msg.Markets.Markets, func(market *feedXML.MarketWithOutcome) bool {
+			return s.isMarketInScope(ctx, market.MarketAttributes)
+		})
+		return &trimmed, len(trimmed.Markets.Markets) != 0
+	case *feedXML.BetCancel:
+		trimmed := *msg
+		trimmed.Markets = keepMarkets(msg.Markets, func(market *feedXML.MarketWithoutOutcome) bool {
+			return s.isMarketInScope(ctx, market.MarketAttributes)
+		})
+		return &trimmed, len(trimmed.Markets) != 0
+	case *feedXML.RollbackBetSettlement:
+		trimmed := *msg
+		trimmed.Markets = keepMarkets(msg.Markets, func(market feedXML.RollbackBetSettlementMarket) bool {
+			return s.isMarketInScope(ctx, market.MarketAttributes)
+		})
+		return &trimmed, len(trimmed.Markets) != 0
+	case *feedXML.RollbackBetCancel:
+		trimmed := *msg
+		trimmed.Markets = keepMarkets(msg.Markets, func(market feedXML.RollbackBetCancelMarket) bool {
+			return s.isMarketInScope(ctx, market.MarketAttributes)
+		})
+		return &trimmed, len(trimmed.Markets) != 0
+	}
+
+	return message, true
+}
+
+// keepMarkets collects markets in scope into new slice, the original one is left untouched
+func keepMarkets[T any](markets []T, inScope func(T) bool) []T {
+	result := make([]T, 0, len(markets))
+	for _, market := range markets {
+		if inScope(market) {
+			result = append(result, market)
+		}
+	}
+
+	return result
+}
+
+func (s *sessionFilter) isMarketInScope(ctx context.Context, market feedXML.MarketAttributes) bool {
+	if len(s.filter.MarketIDs) != 0 && !slices.Contains(s.filter.MarketIDs, market.ID) {
+		return false
+	}
+
+	variant := s.variant(market.Specifiers)
+	if len(s.filter.MarketVariants) != 0 && variant != nil && !slices.Contains(s.filter.MarketVariants, *variant) {
+		return false
+	}
+
+	groupsKey := fmt.Sprintf("groups:%d", market.ID)
+	if variant != nil {
+		groupsKey += ":" + *variant
+	}
+
+	if len(s.filter.MarketGroups) == 0 || s.failed(groupsKey) {
+		return true
+	}
+
+	description := cache.NewMarketDescription(
+		ctx,
+		market.ID,
+		nil,
+		nil,
+		variant,
+		s.cacheManager.MarketDescriptionCache,
+		[]protocols.Locale{s.locale},
+	)
+	groups, err := description.Groups()
+	if err != nil {
+		s.recordFailure(groupsKey, err)
+		return true
+	}
+
+	return slices.ContainsFunc(groups, func(group string) bool {
+		return slices.Contains(s.filter.MarketGroups, group)
+	})
+}
+
+// failed reports whether resolving of the value failed recently
+func (s *sessionFilter) failed(key string) bool {
+	_, ok := s.failures.Get(key)
+	return ok
+}
+
+func (s *sessionFilter) recordFailure(key string, err error) {
+	s.logger.WithError(err).Errorf("failed to resolve %s for session filter", key)
+	s.failures.SetDefault(key, struct{}{})
+}
+
+func (s *sessionFilter) variant(specifiers *string) *string {
+	if specifiers == nil {
+		return nil
+	}
+
+	for _, specifier := range strings.Split(*specifiers, "|") {
+		value, found := strings.CutPrefix(specifier, "variant=")
+		if found {
+			return &value
+		}
+	}
+
+	return nil
+}
+
+func newSessionFilter(
+	filter protocols.SessionFilter,
+	cacheManager *cache.Manager,
+	locale protocols.Locale,
+	logger *log.Entry,
+) *sessionFilter {
+	return &sessionFilter{
+		filter:       filter,
+		cacheManager: cacheManager,
+		locale:       locale,
+		failures:     gocache.New(unresolvedLookupTTL, unresolvedLookupTTL),
+		logger:       logger,
+	}
+}
@@ -0,0 +1,57 @@
+package gosdk
+
+import (
+	"context"
+	"testing"
+
+	feedXML "github.com/oddin-gg/gosdk/internal/feed/xml"
+	"github.com/oddin-gg/gosdk/protocols"
+)
+
+func testMarket(id uint, specifiers string) *feedXML.MarketWithOutcome {
+	market := &feedXML.MarketWithOutcome{MarketAttributes: feedXML.MarketAttributes{ID: id}}
+	if specifiers != "" {
+		market.Specifiers = &specifiers
+	}
+
+	return market
+}
+
+func TestSessionFilterTrimMarkets(t *testing.T) {
+	filter := &sessionFilter{filter: protocols.SessionFilter{
+		MarketIDs:      []uint{1, 2},
+		MarketVariants: []string{"way:two"},
+	}}
+
+	original := &feedXML.OddsChange{Odds: feedXML.Odds{Markets: []*feedXML.MarketWithOutcome{
+		testMarket(1, "variant=way:two"),
+		testMarket(1, "variant=way:three"),
+		testMarket(2, ""),
+		testMarket(3, "variant=way:two"),
+	}}}
+
+	message, ok := filter.trimMarkets(context.Background(), original)
+	if !ok {
+		t.Fatal("odds change was dropped")
+	}
+
+	trimmed := message.(*feedXML.OddsChange)
+	switch {
+	case len(trimmed.Odds.Markets) != 2:
+		t.Fatalf("expected 2 markets, got %d", len(trimmed.Odds.Markets))
+	case trimmed.Odds.Markets[0].ID != 1, trimmed.Odds.Markets[1].ID != 2:
+		t.Fatalf("unexpected markets kept %d and %d", trimmed.Odds.Markets[0].ID, trimmed.Odds.Markets[1].ID)
+	}
+
+	// Parsed message is shared with raw feed message
+	if len(original.Odds.Markets) != 4 || original.Odds.Markets[3].ID != 3 {
+		t.Fatal("original message was modified")
+	}
+
+	settlement := &feedXML.BetSettlement{Markets: feedXML.MarketsWrapper{Markets: []*feedXML.MarketWithOutcome{
+		testMarket(3, ""),
+	}}}
+	if _, ok := filter.trimMarkets(context.Background(), settlement); ok {
+		t.Fatal("settlement without markets was not dropped")
+	}
+}
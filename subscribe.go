@@ -0,0 +1,58 @@
+package gosdk
+
+import (
+	"errors"
+
+	"github.com/oddin-gg/gosdk/protocols"
+)
+
+// subscription receives session messages accepted by the subscription instead of the session channel
+type subscription struct {
+	accepts func(message interface{}) bool
+	deliver func(msg protocols.SessionMessage, closeCh <-chan bool)
+	close   func()
+}
+
+type subscribableSession interface {
+	subscribe(subscription subscription) error
+}
+
+// Subscribe returns channel of session messages of type T, e.g. Subscribe[protocols.BetSettlement](session).
+// Subscribed messages are not delivered to the session channel or listener, other messages are delivered as before.
+// Message is delivered to the first subscription of matching type only. Subscribe has to be called before the
+// feed is opened, channel is closed together with the session.
+//
+// Messages of single event are delivered to the channel in the order they were received, there is no ordering
+// between different channels. Session does not process further messages until the message is read from the channel.
+func Subscribe[T any](session protocols.OddsFeedSession, bufferSize int) (<-chan protocols.TypedMessage[T], error) {
+	s, ok := session.(subscribableSession)
+	if !ok {
+		return nil, errors.New("session does not support subscriptions")
+	}
+
+	ch := make(chan protocols.TypedMessage[T], bufferSize)
+	err := s.subscribe(subscription{
+		accepts: func(message interface{}) bool {
+			_, ok := message.(T)
+			return ok
+		},
+		deliver: func(msg protocols.SessionMessage, closeCh <-chan bool) {
+			select {
+			case ch <- protocols.TypedMessage[T]{
+				Message:      msg.Message.(T),
+				Acknowledger: msg.Acknowledger,
+				FromRecovery: msg.FromRecovery,
+			}:
+			case <-closeCh:
+			}
+		},
+		close: func() {
+			close(ch)
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ch, nil
+}
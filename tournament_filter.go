@@ -0,0 +1,71 @@
+package gosdk
+
+import (
+	"context"
+	"time"
+
+	"github.com/oddin-gg/gosdk/internal/cache"
+	"github.com/oddin-gg/gosdk/protocols"
+	gocache "github.com/patrickmn/go-cache"
+	log "github.com/sirupsen/logrus"
+)
+
+// unresolvedLookupTTL limits how often values of filters which failed to resolve are requested again
+const unresolvedLookupTTL = 1 * time.Minute
+
+// tournamentFilter decides whether event belongs to one of selected tournaments, match tournaments are memoized
+type tournamentFilter struct {
+	tournamentIDs map[protocols.URN]struct{}
+	matchCache    *cache.MatchCache
+	locale        protocols.Locale
+	matches       *gocache.Cache
+	logger        *log.Entry
+}
+
+// isInScope passes messages without event and messages of events which tournament cannot be resolved,
+// failed lookups are memoized for unresolvedLookupTTL
+func (t *tournamentFilter) isInScope(ctx context.Context, eventID *protocols.URN) bool {
+	if eventID == nil {
+		return true
+	}
+
+	switch eventID.Type {
+	case string(protocols.TournamentEventType):
+		_, ok := t.tournamentIDs[*eventID]
+		return ok
+	case string(protocols.MatchEventType):
+	default:
+		return true
+	}
+
+	if inScope, ok := t.matches.Get(eventID.ToString()); ok {
+		return inScope.(bool)
+	}
+
+	tournamentID, err := t.matchCache.TournamentID(ctx, *eventID, t.locale)
+	if err != nil {
+		t.logger.WithError(err).Errorf("failed to resolve tournament of match %s", eventID.ToString())
+		t.matches.Set(eventID.ToString(), true, unresolvedLookupTTL)
+		return true
+	}
+
+	_, inScope := t.tournamentIDs[*tournamentID]
+	t.matches.SetDefault(eventID.ToString(), inScope)
+
+	return inScope
+}
+
+func newTournamentFilter(
+	tournamentIDs map[protocols.URN]struct{},
+	matchCache *cache.MatchCache,
+	locale protocols.Locale,
+	logger *log.Entry,
+) *tournamentFilter {
+	return &tournamentFilter{
+		tournamentIDs: tournamentIDs,
+		matchCache:    matchCache,
+		locale:        locale,
+		matches:       gocache.New(12*time.Hour, 10*time.Minute),
+		logger:        logger,
+	}
+}
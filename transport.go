@@ -0,0 +1,12 @@
+package gosdk
+
+import (
+	"github.com/oddin-gg/gosdk/internal/feed"
+	"github.com/oddin-gg/gosdk/protocols"
+)
+
+// NewInMemoryTransport creates transport which routes published messages to sessions without a broker, clock
+// of the configuration should be passed so connection statuses are stamped by the same time as other messages
+func NewInMemoryTransport(clock protocols.Clock) protocols.InMemoryTransport {
+	return feed.NewMemoryTransport(clock)
+}